@@ -1,9 +1,6 @@
 package intercom
 
-import (
-	"encoding/json"
-	"fmt"
-)
+import "fmt"
 
 // AdminAvatar represents an admin's avatar
 type AdminAvatar struct {
@@ -12,11 +9,25 @@ type AdminAvatar struct {
 
 // Admin represents an Admin in Intercom.
 type Admin struct {
-	ID     json.Number  `json:"id"`
-	Type   string       `json:"type"`
-	Name   string       `json:"name"`
-	Email  string       `json:"email"`
-	Avatar *AdminAvatar `json:"avatar"`
+	ID               ID           `json:"id"`
+	Type             string       `json:"type"`
+	Name             string       `json:"name"`
+	Email            string       `json:"email"`
+	Avatar           *AdminAvatar `json:"avatar"`
+	AwayModeEnabled  bool         `json:"away_mode_enabled"`
+	AwayModeReassign bool         `json:"away_mode_reassign"`
+	TeamIDs          []ID         `json:"team_ids"`
+	App              *AdminApp    `json:"app,omitempty"`
+}
+
+// AdminApp is the App, or workspace, an Admin belongs to, which is only included by Me.
+type AdminApp struct {
+	Type      string `json:"type"`
+	IDCode    string `json:"id_code"`
+	Name      string `json:"name"`
+	Region    string `json:"region"`
+	Timezone  string `json:"timezone"`
+	CreatedAt int64  `json:"created_at"`
 }
 
 // AdminList represents an object holding list of Admins
@@ -36,9 +47,17 @@ func (c *AdminService) List() (AdminList, error) {
 
 // Read reads an Admin associated with your App.
 func (c *AdminService) Read(adminID string) (Admin, error) {
+	if adminID == "" {
+		return Admin{}, missing("Admin ID")
+	}
 	return c.Repository.read(adminID)
 }
 
+// Me reads the Admin who owns the access token in use, with their App.
+func (c *AdminService) Me() (Admin, error) {
+	return c.Repository.me()
+}
+
 // IsNobodyAdmin is a helper function to determine if the Admin is 'Nobody'.
 func (a Admin) IsNobodyAdmin() bool {
 	return a.Type == "nobody_admin"
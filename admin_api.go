@@ -1,7 +1,6 @@
 package intercom
 
 import (
-	"encoding/json"
 	"gopkg.in/intercom/intercom-go.v2/interfaces"
 )
 
@@ -9,6 +8,7 @@ import (
 type AdminRepository interface {
 	list() (AdminList, error)
 	read(string) (Admin, error)
+	me() (Admin, error)
 }
 
 // AdminAPI implements AdminRepository
@@ -22,16 +22,27 @@ func (api AdminAPI) list() (AdminList, error) {
 	if err != nil {
 		return adminList, err
 	}
-	err = json.Unmarshal(data, &adminList)
+	err = unmarshal("/admins", data, &adminList)
 	return adminList, err
 }
 
 func (api AdminAPI) read(adminID string) (Admin, error) {
 	admin := Admin{}
-	data, err := api.httpClient.Get("/admins/"+adminID, nil)
+	uri := "/admins/" + adminID
+	data, err := api.httpClient.Get(uri, nil)
 	if err != nil {
 		return admin, err
 	}
-	err = json.Unmarshal(data, &admin)
+	err = unmarshal(uri, data, &admin)
+	return admin, err
+}
+
+func (api AdminAPI) me() (Admin, error) {
+	admin := Admin{}
+	data, err := api.httpClient.Get("/me", nil)
+	if err != nil {
+		return admin, err
+	}
+	err = unmarshal("/me", data, &admin)
 	return admin, err
 }
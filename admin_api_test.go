@@ -6,7 +6,7 @@ import (
 )
 
 func TestAdminAPIList(t *testing.T) {
-	http := TestAdminHTTPClient{fixtureFilename: "fixtures/admins.json", expectedURI: "/admins", t: t}
+	http := TestAdminHTTPClient{fixtureFilename: "intercomtest/fixtures/admins.json", expectedURI: "/admins", t: t}
 	api := AdminAPI{httpClient: &http}
 	adminList, _ := api.list()
 	if adminList.Admins[0].ID != "1" {
@@ -15,7 +15,7 @@ func TestAdminAPIList(t *testing.T) {
 }
 
 func TestAdminAPIRead(t *testing.T) {
-	http := TestAdminHTTPClient{fixtureFilename: "fixtures/admin.json", expectedURI: "/admins/123", t: t}
+	http := TestAdminHTTPClient{fixtureFilename: "intercomtest/fixtures/admin.json", expectedURI: "/admins/123", t: t}
 	api := AdminAPI{httpClient: &http}
 	admin, err := api.read("123")
 	if err != nil {
@@ -27,6 +27,18 @@ func TestAdminAPIRead(t *testing.T) {
 	}
 }
 
+func TestAdminAPIMe(t *testing.T) {
+	http := TestAdminHTTPClient{fixtureFilename: "intercomtest/fixtures/me.json", expectedURI: "/me", t: t}
+	api := AdminAPI{httpClient: &http}
+	admin, err := api.me()
+	if err != nil {
+		t.Errorf("Error reading me: %v", err)
+	}
+	if admin.App == nil || admin.App.IDCode != "this_is_an_id64_that_should_be_at_least_4" {
+		t.Errorf("App was %+v", admin.App)
+	}
+}
+
 type TestAdminHTTPClient struct {
 	TestHTTPClient
 	t               *testing.T
@@ -31,6 +31,14 @@ func TestAdminList(t *testing.T) {
 	}
 }
 
+func TestAdminMe(t *testing.T) {
+	adminService := AdminService{Repository: TestAdminAPI{t: t}}
+	admin, _ := adminService.Me()
+	if admin.App.IDCode != "abc123" {
+		t.Errorf("App was %+v", admin.App)
+	}
+}
+
 type TestAdminAPI struct {
 	t *testing.T
 }
@@ -50,3 +58,7 @@ func (t TestAdminAPI) read(string) (Admin, error) {
 		},
 	}, nil
 }
+
+func (t TestAdminAPI) me() (Admin, error) {
+	return Admin{Type: "admin", ID: "123", App: &AdminApp{IDCode: "abc123"}}, nil
+}
@@ -0,0 +1,34 @@
+package intercom
+
+import "reflect"
+
+// ArgumentError is returned, before any request is made, when a call is missing something it needs,
+// such as an ID. It's a mistake in the call, where a ValidationError is the API rejecting a request:
+//
+//	var argumentErr intercom.ArgumentError
+//	if errors.As(err, &argumentErr) {
+//		fmt.Println(argumentErr.Message)
+//	}
+type ArgumentError struct {
+	Message string
+}
+
+func (e ArgumentError) Error() string {
+	return e.Message
+}
+
+func missing(what string) error {
+	return ArgumentError{Message: "Missing " + what}
+}
+
+// identified reports whether someone has an ID, UserID or Email to identify them in a request.
+func identified(person MessagePerson) bool {
+	if person == nil {
+		return false
+	}
+	if value := reflect.ValueOf(person); value.Kind() == reflect.Ptr && value.IsNil() {
+		return false
+	}
+	address := person.MessageAddress()
+	return address.ID != "" || address.UserID != "" || address.Email != ""
+}
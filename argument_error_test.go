@@ -0,0 +1,40 @@
+package intercom
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestArgumentErrors(t *testing.T) {
+	conversations := ConversationService{Repository: TestConversationAPI{t: t, testFunc: func(t *testing.T, params interface{}) {
+		t.Errorf("Expected no request to be made, got %+v", params)
+	}}}
+	var nilAdmin *Admin
+	errOf := func(_ Conversation, err error) error { return err }
+	for name, call := range map[string]func() error{
+		"find":            func() error { return errOf(conversations.Find("")) },
+		"mark read":       func() error { return errOf(conversations.MarkRead("")) },
+		"reply":           func() error { return errOf(conversations.Reply("", &Admin{ID: "1"}, CONVERSATION_COMMENT, "Hi")) },
+		"anonymous reply": func() error { return errOf(conversations.Reply("123", &User{Name: "Bob"}, CONVERSATION_COMMENT, "Hi")) },
+		"nil author":      func() error { return errOf(conversations.Reply("123", nil, CONVERSATION_COMMENT, "Hi")) },
+		"nil admin":       func() error { return errOf(conversations.Close("123", nilAdmin)) },
+		"assign":          func() error { return errOf(conversations.Assign("123", &Admin{ID: "1"}, nilAdmin)) },
+	} {
+		err := call()
+		var argumentErr ArgumentError
+		if !errors.As(err, &argumentErr) {
+			t.Errorf("Expected an ArgumentError from %s, got %v", name, err)
+		}
+		var invalid ValidationError
+		if errors.As(err, &invalid) {
+			t.Errorf("Expected the ArgumentError from %s not to be a ValidationError", name)
+		}
+	}
+
+	if _, err := conversations.Find(""); err.Error() != "Missing Conversation ID" {
+		t.Errorf("Error was %v", err)
+	}
+	if _, err := (&UserService{Repository: UserAPI{httpClient: TestHTTPClient{}}}).FindByEmail(""); !errors.As(err, &ArgumentError{}) {
+		t.Errorf("Expected an ArgumentError for a missing User Identifier, got %v", err)
+	}
+}
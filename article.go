@@ -0,0 +1,160 @@
+package intercom
+
+import "fmt"
+
+// ArticleService handles interactions with the API through an ArticleRepository.
+type ArticleService struct {
+	Repository ArticleRepository
+}
+
+// Article represents a Help Center Article in Intercom.
+// State is either "published" or "draft".
+type Article struct {
+	Type        string `json:"type,omitempty"`
+	ID          string `json:"id,omitempty"`
+	WorkspaceID string `json:"workspace_id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Body        string `json:"body,omitempty"`
+	AuthorID    ID     `json:"author_id,omitempty"`
+	State       string `json:"state,omitempty"`
+	ParentID    ID     `json:"parent_id,omitempty"`
+	ParentType  string `json:"parent_type,omitempty"`
+	URL         string `json:"url,omitempty"`
+	CreatedAt   int64  `json:"created_at,omitempty"`
+	UpdatedAt   int64  `json:"updated_at,omitempty"`
+}
+
+// ArticleList holds a page of Articles and paging information
+type ArticleList struct {
+	Pages      CursorPages `json:"pages"`
+	TotalCount int64       `json:"total_count"`
+	Articles   []Article   `json:"data"`
+}
+
+// ArticleSearchOptions narrow an Article search.
+// State is "published" or "draft", and Highlight requests highlights of the matching text.
+type ArticleSearchOptions struct {
+	State        string
+	HelpCenterID string
+	Highlight    bool
+}
+
+// ArticleSearchResult holds the Articles matching a search, and their highlights if requested.
+type ArticleSearchResult struct {
+	Pages      CursorPages
+	TotalCount int64
+	Articles   []Article
+	Highlights []ArticleHighlight
+}
+
+// ArticleHighlight holds the text matching a search in an Article's title and summary.
+type ArticleHighlight struct {
+	ArticleID          string              `json:"article_id"`
+	HighlightedTitle   []HighlightedText   `json:"highlighted_title"`
+	HighlightedSummary [][]HighlightedText `json:"highlighted_summary"`
+}
+
+// HighlightedText is a fragment of highlighted text, with Type "highlight" for
+// the matching parts and "plain" otherwise.
+type HighlightedText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type articleSearchParams struct {
+	Phrase       string `url:"phrase"`
+	State        string `url:"state,omitempty"`
+	HelpCenterID string `url:"help_center_id,omitempty"`
+	Highlight    bool   `url:"highlight,omitempty"`
+}
+
+// Find an Article by its ID.
+func (a *ArticleService) Find(id string) (Article, error) {
+	if id == "" {
+		return Article{}, missing("Article ID")
+	}
+	return a.Repository.find(id)
+}
+
+// List a page of Articles. Pass Pages.Next.StartingAfter from the previous
+// ArticleList as params.StartingAfter to get the next page.
+func (a *ArticleService) List(params CursorParams) (ArticleList, error) {
+	params, err := params.limit(MaxCursorPerPage)
+	if err != nil {
+		return ArticleList{}, err
+	}
+	return a.Repository.list(params)
+}
+
+// All returns a Pager over every Article, starting at the page params.
+func (a *ArticleService) All(params CursorParams) *Pager[Article] {
+	start := PageParams{PerPage: params.PerPage, StartingAfter: params.StartingAfter}
+	return NewPager(start, func(params PageParams) ([]Article, PageParams, error) {
+		params, err := params.limit(MaxCursorPerPage)
+		if err != nil {
+			return nil, PageParams{}, err
+		}
+		articleList, err := a.Repository.list(CursorParams{PerPage: params.PerPage, StartingAfter: params.StartingAfter})
+		pages := articleList.Pages
+		return articleList.Articles, PageParams{Page: pages.Page, PerPage: pages.PerPage, TotalPages: pages.TotalPages, Next: pages.Next}, err
+	})
+}
+
+// ListAll walks every page of Articles, calling fn with each Article.
+// It stops at the first error, from the API or returned by fn.
+func (a *ArticleService) ListAll(fn func(Article) error) error {
+	articles := a.All(CursorParams{})
+	for articles.Next() {
+		if err := fn(articles.Item()); err != nil {
+			return err
+		}
+	}
+	return articles.Err()
+}
+
+// Search Articles for a phrase. Articles is empty, rather than nil, if nothing matches.
+func (a *ArticleService) Search(phrase string, opts ArticleSearchOptions) (ArticleSearchResult, error) {
+	if phrase == "" {
+		return ArticleSearchResult{}, missing("Search Phrase")
+	}
+	result, err := a.Repository.search(articleSearchParams{
+		Phrase:       phrase,
+		State:        opts.State,
+		HelpCenterID: opts.HelpCenterID,
+		Highlight:    opts.Highlight,
+	})
+	if result.Articles == nil {
+		result.Articles = []Article{}
+	}
+	return result, err
+}
+
+// Create an Article, which needs a Title and AuthorID.
+func (a *ArticleService) Create(article *Article) (Article, error) {
+	if article.Title == "" || article.AuthorID == "" {
+		return Article{}, missing("Article Title or Author")
+	}
+	return a.Repository.create(article)
+}
+
+// Update an Article. Only the fields set on article are sent, so, for example,
+// an Article can be unpublished by updating with just its State set to "draft".
+func (a *ArticleService) Update(id string, article *Article) (Article, error) {
+	if id == "" {
+		return Article{}, missing("Article ID")
+	}
+	return a.Repository.update(id, article)
+}
+
+// Delete an Article by its ID.
+func (a *ArticleService) Delete(id string) error {
+	if id == "" {
+		return missing("Article ID")
+	}
+	return a.Repository.delete(id)
+}
+
+func (a Article) String() string {
+	return fmt.Sprintf("[intercom] article { id: %s, title: %s, state: %s }", a.ID, a.Title, a.State)
+}
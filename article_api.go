@@ -0,0 +1,118 @@
+package intercom
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// ArticleRepository defines the interface for working with Articles through the API.
+type ArticleRepository interface {
+	find(id string) (Article, error)
+	list(params CursorParams) (ArticleList, error)
+	create(*Article) (Article, error)
+	update(id string, article *Article) (Article, error)
+	delete(id string) error
+	search(params articleSearchParams) (ArticleSearchResult, error)
+}
+
+// ArticleAPI implements ArticleRepository
+type ArticleAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+type requestArticle struct {
+	Title       string      `json:"title,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Body        string      `json:"body,omitempty"`
+	AuthorID    json.Number `json:"author_id,omitempty"`
+	State       string      `json:"state,omitempty"`
+	ParentID    json.Number `json:"parent_id,omitempty"`
+	ParentType  string      `json:"parent_type,omitempty"`
+}
+
+func (api ArticleAPI) find(id string) (Article, error) {
+	uri := fmt.Sprintf("/articles/%s", id)
+	data, err := api.httpClient.Get(uri, nil)
+	if err != nil {
+		return Article{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api ArticleAPI) list(params CursorParams) (ArticleList, error) {
+	articleList := ArticleList{}
+	data, err := api.httpClient.Get("/articles", params)
+	if err != nil {
+		return articleList, err
+	}
+	err = unmarshal("/articles", data, &articleList)
+	return articleList, err
+}
+
+func (api ArticleAPI) create(article *Article) (Article, error) {
+	data, err := api.httpClient.Post("/articles", buildRequestArticle(article))
+	if err != nil {
+		return Article{}, err
+	}
+	return api.unmarshal("/articles", data)
+}
+
+func (api ArticleAPI) update(id string, article *Article) (Article, error) {
+	uri := fmt.Sprintf("/articles/%s", id)
+	data, err := put(api.httpClient, uri, buildRequestArticle(article))
+	if err != nil {
+		return Article{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api ArticleAPI) delete(id string) error {
+	_, err := api.httpClient.Delete(fmt.Sprintf("/articles/%s", id), nil)
+	return err
+}
+
+type articleSearchResponse struct {
+	Pages      CursorPages `json:"pages"`
+	TotalCount int64       `json:"total_count"`
+	Data       struct {
+		Articles   []Article          `json:"articles"`
+		Highlights []ArticleHighlight `json:"highlights"`
+	} `json:"data"`
+}
+
+func (api ArticleAPI) search(params articleSearchParams) (ArticleSearchResult, error) {
+	data, err := api.httpClient.Get("/articles/search", params)
+	if err != nil {
+		return ArticleSearchResult{}, err
+	}
+	response := articleSearchResponse{}
+	if err := unmarshal("/articles/search", data, &response); err != nil {
+		return ArticleSearchResult{}, err
+	}
+	return ArticleSearchResult{
+		Pages:      response.Pages,
+		TotalCount: response.TotalCount,
+		Articles:   response.Data.Articles,
+		Highlights: response.Data.Highlights,
+	}, nil
+}
+
+func (api ArticleAPI) unmarshal(uri string, data []byte) (Article, error) {
+	article := Article{}
+	err := unmarshal(uri, data, &article)
+	return article, err
+}
+
+func buildRequestArticle(article *Article) *requestArticle {
+	return &requestArticle{
+		Title:       article.Title,
+		Description: article.Description,
+		Body:        article.Body,
+		AuthorID:    json.Number(article.AuthorID),
+		State:       article.State,
+		ParentID:    json.Number(article.ParentID),
+		ParentType:  article.ParentType,
+	}
+}
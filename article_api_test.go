@@ -0,0 +1,102 @@
+package intercom
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestAPIFindArticle(t *testing.T) {
+	http := TestArticleHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/article.json", expectedURI: "/articles/39"}
+	api := ArticleAPI{httpClient: &http}
+	article, err := api.find("39")
+	if err != nil {
+		t.Fatalf("Error finding article: %v", err)
+	}
+	if article.ID != "39" || article.AuthorID != "991267497" || article.ParentID != "143" {
+		t.Errorf("Article was not parsed, got %v", article)
+	}
+}
+
+func TestAPIListArticles(t *testing.T) {
+	http := TestArticleHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/articles.json", expectedURI: "/articles"}
+	api := ArticleAPI{httpClient: &http}
+	articleList, err := api.list(CursorParams{StartingAfter: "WzE2NjM1OTcyMjMwMDAsMzBd"})
+	if err != nil {
+		t.Fatalf("Error listing articles: %v", err)
+	}
+	if http.lastQueryParams.(CursorParams).StartingAfter != "WzE2NjM1OTcyMjMwMDAsMzBd" {
+		t.Errorf("Cursor was not requested, got %v", http.lastQueryParams)
+	}
+	if articleList.Pages.Next == nil || articleList.Pages.Next.StartingAfter != "WzE2NjM1OTcyMjMwMDAsMzld" {
+		t.Errorf("Next cursor was not parsed, got %v", articleList.Pages.Next)
+	}
+	if len(articleList.Articles) != 1 || articleList.Articles[0].Title != "Thanks for everything" {
+		t.Errorf("Articles were %v", articleList.Articles)
+	}
+}
+
+func TestAPIUpdateArticlePartial(t *testing.T) {
+	http := TestArticleHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/article.json", expectedURI: "/articles/39"}
+	api := ArticleAPI{httpClient: &http}
+	api.update("39", &Article{State: "draft"})
+	b, _ := json.Marshal(http.lastRequest)
+	if string(b) != `{"state":"draft"}` {
+		t.Errorf("Request was %s, expected only state", b)
+	}
+}
+
+func TestAPISearchArticles(t *testing.T) {
+	http := TestArticleHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/article_search.json", expectedURI: "/articles/search"}
+	api := ArticleAPI{httpClient: &http}
+	result, err := api.search(articleSearchParams{Phrase: `"refunds" & returns`, State: "published", Highlight: true})
+	if err != nil {
+		t.Fatalf("Error searching articles: %v", err)
+	}
+	values, _ := query.Values(http.lastQueryParams)
+	if values.Encode() != "highlight=true&phrase=%22refunds%22+%26+returns&state=published" {
+		t.Errorf("Query was %s", values.Encode())
+	}
+	if result.TotalCount != 1 || result.Articles[0].ID != "45" {
+		t.Errorf("Articles were %v", result.Articles)
+	}
+	highlight := result.Highlights[0]
+	if highlight.ArticleID != "45" || highlight.HighlightedTitle[1].Type != "highlight" || highlight.HighlightedSummary[0][0].Text != "How to request " {
+		t.Errorf("Highlights were not parsed, got %v", highlight)
+	}
+}
+
+type TestArticleHTTPClient struct {
+	TestHTTPClient
+	t               *testing.T
+	fixtureFilename string
+	expectedURI     string
+	lastQueryParams interface{}
+	lastRequest     interface{}
+}
+
+func (t *TestArticleHTTPClient) Get(uri string, params interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	t.lastQueryParams = params
+	return ioutil.ReadFile(t.fixtureFilename)
+}
+
+func (t *TestArticleHTTPClient) Post(uri string, body interface{}) ([]byte, error) {
+	return t.save(uri, body)
+}
+
+func (t *TestArticleHTTPClient) Put(uri string, body interface{}) ([]byte, error) {
+	return t.save(uri, body)
+}
+
+func (t *TestArticleHTTPClient) save(uri string, body interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	t.lastRequest = body
+	return ioutil.ReadFile(t.fixtureFilename)
+}
@@ -0,0 +1,84 @@
+package intercom
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCreateArticleMissingAuthor(t *testing.T) {
+	articleService := ArticleService{Repository: &TestArticleAPI{t: t}}
+	if _, err := articleService.Create(&Article{Title: "Thanks for everything"}); err == nil {
+		t.Errorf("Expected an error for a missing author")
+	}
+}
+
+func TestListAllArticles(t *testing.T) {
+	articleService := ArticleService{Repository: &TestArticleAPI{t: t, pages: 3}}
+	ids := []string{}
+	err := articleService.ListAll(func(article Article) error {
+		ids = append(ids, article.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error listing articles: %v", err)
+	}
+	if fmt.Sprint(ids) != "[1 2 3]" {
+		t.Errorf("Articles were %v, expected [1 2 3]", ids)
+	}
+}
+
+func TestSearchArticlesEmpty(t *testing.T) {
+	articleService := ArticleService{Repository: &TestArticleAPI{t: t}}
+	result, err := articleService.Search("nothing matches", ArticleSearchOptions{})
+	if err != nil {
+		t.Fatalf("Error searching articles: %v", err)
+	}
+	if result.Articles == nil || len(result.Articles) != 0 {
+		t.Errorf("Expected an empty slice of articles, got %#v", result.Articles)
+	}
+}
+
+func TestSearchArticlesMissingPhrase(t *testing.T) {
+	articleService := ArticleService{Repository: &TestArticleAPI{t: t}}
+	if _, err := articleService.Search("", ArticleSearchOptions{}); err == nil {
+		t.Errorf("Expected an error for a missing phrase")
+	}
+}
+
+type TestArticleAPI struct {
+	t     *testing.T
+	pages int
+}
+
+func (t *TestArticleAPI) find(id string) (Article, error) {
+	return Article{ID: id}, nil
+}
+
+func (t *TestArticleAPI) list(params CursorParams) (ArticleList, error) {
+	page := 1
+	if params.StartingAfter != "" {
+		fmt.Sscanf(params.StartingAfter, "after-%d", &page)
+		page++
+	}
+	articleList := ArticleList{Articles: []Article{Article{ID: fmt.Sprint(page)}}}
+	if page < t.pages {
+		articleList.Pages.Next = &CursorNext{Page: int64(page + 1), StartingAfter: fmt.Sprintf("after-%d", page)}
+	}
+	return articleList, nil
+}
+
+func (t *TestArticleAPI) create(article *Article) (Article, error) {
+	return *article, nil
+}
+
+func (t *TestArticleAPI) update(id string, article *Article) (Article, error) {
+	return *article, nil
+}
+
+func (t *TestArticleAPI) delete(id string) error {
+	return nil
+}
+
+func (t *TestArticleAPI) search(params articleSearchParams) (ArticleSearchResult, error) {
+	return ArticleSearchResult{}, nil
+}
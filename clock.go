@@ -0,0 +1,62 @@
+package intercom
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// clockOrReal returns the clock, or the real one if it's nil, as it is for services made without a Client.
+func clockOrReal(clock interfaces.Clock) interfaces.Clock {
+	if clock == nil {
+		return interfaces.RealClock{}
+	}
+	return clock
+}
+
+// settingsClock is the Clock of a Client's services, which is the Client's Clock as it is when
+// they use it, read under the settings lock.
+type settingsClock struct {
+	clock    *interfaces.Clock
+	settings *sync.RWMutex
+}
+
+func (c settingsClock) current() interfaces.Clock {
+	c.settings.RLock()
+	defer c.settings.RUnlock()
+	return clockOrReal(*c.clock)
+}
+
+func (c settingsClock) Now() time.Time {
+	return c.current().Now()
+}
+
+func (c settingsClock) After(d time.Duration) <-chan time.Time {
+	return c.current().After(d)
+}
+
+// sleep waits for d by the clock.
+func sleep(clock interfaces.Clock, d time.Duration) {
+	<-clockOrReal(clock).After(d)
+}
+
+// rateLimitBackoff is how long to wait before trying again after err, and whether err was rate limited
+// at all: as long as the RateLimitError's Retry-After or Reset says, else twice the last wait, up to max.
+func rateLimitBackoff(err error, now time.Time, last, max time.Duration) (time.Duration, bool) {
+	if !errors.Is(err, ErrRateLimited) {
+		return 0, false
+	}
+	var rateLimited RateLimitError
+	if errors.As(err, &rateLimited) {
+		if delay, ok := rateLimited.RetryDelay(now); ok {
+			return delay, true
+		}
+	}
+	wait := 2 * last
+	if wait > max {
+		wait = max
+	}
+	return wait, true
+}
@@ -0,0 +1,68 @@
+package intercom
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// testClock is a Clock whose time only moves on when it's waited on, by the time waited.
+type testClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	waits []time.Duration
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.waits = append(c.waits, d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func TestSetClock(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"type": "admin.list", "admins": []}`))
+	}))
+	defer server.Close()
+
+	clock := &testClock{now: time.Unix(1500000000, 0)}
+	ic := NewClient("app_id", "api_key", BaseURI(server.URL), SetClock(clock), RetryRequests(interfaces.RetryOptions{MaxAttempts: 3, MaxDelay: time.Minute}))
+	start := time.Now()
+	if _, err := ic.Admins.List(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(clock.waits) != 2 || clock.waits[0] != time.Minute || time.Since(start) > 10*time.Second {
+		t.Errorf("Expected two instant waits of a minute, got %v in %s", clock.waits, time.Since(start))
+	}
+
+	ic.Events.SkipMetadataValidation = true
+	previous := ic.Option(SetClock(nil))
+	if time.Since(ic.Events.now()) > time.Minute || !ic.Events.SkipMetadataValidation {
+		t.Errorf("Expected the services to have the real clock and keep their options")
+	}
+	ic.Option(previous)
+	if !ic.Tags.clock.Now().Equal(clock.Now()) || !ic.Jobs.clock.Now().Equal(clock.Now()) {
+		t.Errorf("Expected the services to have the clock again")
+	}
+}
@@ -0,0 +1,77 @@
+package intercom
+
+import "fmt"
+
+// CollectionService handles interactions with the API through a CollectionRepository.
+type CollectionService struct {
+	Repository CollectionRepository
+}
+
+// Collection represents a Help Center Collection, which groups Sections and Articles.
+type Collection struct {
+	Type              string                 `json:"type,omitempty"`
+	ID                string                 `json:"id,omitempty"`
+	WorkspaceID       string                 `json:"workspace_id,omitempty"`
+	Name              string                 `json:"name,omitempty"`
+	Description       string                 `json:"description,omitempty"`
+	TranslatedContent GroupTranslatedContent `json:"translated_content,omitempty"`
+	Order             int64                  `json:"order,omitempty"`
+	DefaultLocale     string                 `json:"default_locale,omitempty"`
+	HelpCenterID      string                 `json:"help_center_id,omitempty"`
+	URL               string                 `json:"url,omitempty"`
+	IconURL           string                 `json:"icon,omitempty"`
+	CreatedAt         int64                  `json:"created_at,omitempty"`
+	UpdatedAt         int64                  `json:"updated_at,omitempty"`
+}
+
+// CollectionList holds a page of Collections and paging information
+type CollectionList struct {
+	Pages       PageParams   `json:"pages"`
+	TotalCount  int64        `json:"total_count"`
+	Collections []Collection `json:"data"`
+}
+
+// Find a Collection by its ID.
+func (c *CollectionService) Find(id string) (Collection, error) {
+	if id == "" {
+		return Collection{}, missing("Collection ID")
+	}
+	return c.Repository.find(id)
+}
+
+// List a page of Collections.
+func (c *CollectionService) List(params PageParams) (CollectionList, error) {
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return CollectionList{}, err
+	}
+	return c.Repository.list(params)
+}
+
+// Create a Collection, which needs a Name.
+func (c *CollectionService) Create(collection *Collection) (Collection, error) {
+	if collection.Name == "" {
+		return Collection{}, missing("Collection Name")
+	}
+	return c.Repository.create(collection)
+}
+
+// Update a Collection. Only the fields set on collection are sent.
+func (c *CollectionService) Update(id string, collection *Collection) (Collection, error) {
+	if id == "" {
+		return Collection{}, missing("Collection ID")
+	}
+	return c.Repository.update(id, collection)
+}
+
+// Delete a Collection by its ID.
+func (c *CollectionService) Delete(id string) error {
+	if id == "" {
+		return missing("Collection ID")
+	}
+	return c.Repository.delete(id)
+}
+
+func (c Collection) String() string {
+	return fmt.Sprintf("[intercom] collection { id: %s, name: %s }", c.ID, c.Name)
+}
@@ -0,0 +1,84 @@
+package intercom
+
+import (
+	"fmt"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// CollectionRepository defines the interface for working with Collections through the API.
+type CollectionRepository interface {
+	find(id string) (Collection, error)
+	list(params PageParams) (CollectionList, error)
+	create(*Collection) (Collection, error)
+	update(id string, collection *Collection) (Collection, error)
+	delete(id string) error
+}
+
+// CollectionAPI implements CollectionRepository
+type CollectionAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+type requestCollection struct {
+	Name              string                 `json:"name,omitempty"`
+	Description       string                 `json:"description,omitempty"`
+	TranslatedContent GroupTranslatedContent `json:"translated_content,omitempty"`
+	HelpCenterID      string                 `json:"help_center_id,omitempty"`
+}
+
+func (api CollectionAPI) find(id string) (Collection, error) {
+	uri := fmt.Sprintf("/help_center/collections/%s", id)
+	data, err := api.httpClient.Get(uri, nil)
+	if err != nil {
+		return Collection{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api CollectionAPI) list(params PageParams) (CollectionList, error) {
+	collectionList := CollectionList{}
+	data, err := api.httpClient.Get("/help_center/collections", params)
+	if err != nil {
+		return collectionList, err
+	}
+	err = unmarshal("/help_center/collections", data, &collectionList)
+	return collectionList, err
+}
+
+func (api CollectionAPI) create(collection *Collection) (Collection, error) {
+	data, err := api.httpClient.Post("/help_center/collections", buildRequestCollection(collection))
+	if err != nil {
+		return Collection{}, err
+	}
+	return api.unmarshal("/help_center/collections", data)
+}
+
+func (api CollectionAPI) update(id string, collection *Collection) (Collection, error) {
+	uri := fmt.Sprintf("/help_center/collections/%s", id)
+	data, err := put(api.httpClient, uri, buildRequestCollection(collection))
+	if err != nil {
+		return Collection{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api CollectionAPI) delete(id string) error {
+	_, err := api.httpClient.Delete(fmt.Sprintf("/help_center/collections/%s", id), nil)
+	return err
+}
+
+func (api CollectionAPI) unmarshal(uri string, data []byte) (Collection, error) {
+	collection := Collection{}
+	err := unmarshal(uri, data, &collection)
+	return collection, err
+}
+
+func buildRequestCollection(collection *Collection) *requestCollection {
+	return &requestCollection{
+		Name:              collection.Name,
+		Description:       collection.Description,
+		TranslatedContent: collection.TranslatedContent,
+		HelpCenterID:      collection.HelpCenterID,
+	}
+}
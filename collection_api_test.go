@@ -0,0 +1,67 @@
+package intercom
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+)
+
+func TestAPIFindCollection(t *testing.T) {
+	http := TestCollectionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/collection.json", expectedURI: "/help_center/collections/165"}
+	api := CollectionAPI{httpClient: &http}
+	collection, err := api.find("165")
+	if err != nil {
+		t.Fatalf("Error finding collection: %v", err)
+	}
+	if collection.ID != "165" || collection.Order != 3 || collection.HelpCenterID != "14" {
+		t.Errorf("Collection was not parsed, got %v", collection)
+	}
+	if len(collection.TranslatedContent) != 2 || collection.TranslatedContent["fr"].Name != "Facturation" {
+		t.Errorf("Translated content was %v", collection.TranslatedContent)
+	}
+}
+
+func TestAPIListCollections(t *testing.T) {
+	http := TestCollectionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/collections.json", expectedURI: "/help_center/collections"}
+	api := CollectionAPI{httpClient: &http}
+	collectionList, err := api.list(PageParams{Page: 1})
+	if err != nil {
+		t.Fatalf("Error listing collections: %v", err)
+	}
+	if len(collectionList.Collections) != 1 || collectionList.Pages.TotalPages != 1 {
+		t.Errorf("Collections were not parsed, got %v", collectionList)
+	}
+}
+
+func TestAPIUpdateCollectionPartial(t *testing.T) {
+	http := TestCollectionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/collection.json", expectedURI: "/help_center/collections/165"}
+	api := CollectionAPI{httpClient: &http}
+	api.update("165", &Collection{Name: "Billing"})
+	b, _ := json.Marshal(http.lastRequest)
+	if string(b) != `{"name":"Billing"}` {
+		t.Errorf("Request was %s, expected only name", b)
+	}
+}
+
+type TestCollectionHTTPClient struct {
+	TestHTTPClient
+	t               *testing.T
+	fixtureFilename string
+	expectedURI     string
+	lastRequest     interface{}
+}
+
+func (t *TestCollectionHTTPClient) Get(uri string, params interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	return ioutil.ReadFile(t.fixtureFilename)
+}
+
+func (t *TestCollectionHTTPClient) Put(uri string, body interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	t.lastRequest = body
+	return ioutil.ReadFile(t.fixtureFilename)
+}
@@ -0,0 +1,93 @@
+package intercom
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+)
+
+func TestCreateCollectionMissingName(t *testing.T) {
+	collectionService := CollectionService{Repository: TestCollectionAPI{}}
+	if _, err := collectionService.Create(&Collection{Description: "Payments and refunds"}); err == nil {
+		t.Errorf("Expected an error for a missing name")
+	}
+}
+
+func TestCreateArticleInNewCollection(t *testing.T) {
+	http := &TestHelpCenterHTTPClient{t: t, fixtures: map[string]string{
+		"/help_center/collections": "intercomtest/fixtures/collection.json",
+		"/articles":                "intercomtest/fixtures/article.json",
+	}}
+	ic := NewClient("app_id", "api_key")
+	ic.Option(SetHTTPClient(http))
+
+	collection, err := ic.Collections.Create(&Collection{Name: "Billing", Description: "Payments and refunds"})
+	if err != nil {
+		t.Fatalf("Error creating collection: %v", err)
+	}
+	_, err = ic.Articles.Create(&Article{
+		Title:      "Thanks for everything",
+		AuthorID:   "991267497",
+		ParentID:   ID(collection.ID),
+		ParentType: "collection",
+	})
+	if err != nil {
+		t.Fatalf("Error creating article: %v", err)
+	}
+	b, _ := json.Marshal(http.requests["/articles"])
+	expected := `{"title":"Thanks for everything","author_id":991267497,"parent_id":165,"parent_type":"collection"}`
+	if string(b) != expected {
+		t.Errorf("Article request was %s, expected %s", b, expected)
+	}
+}
+
+type TestCollectionAPI struct{}
+
+func (t TestCollectionAPI) find(id string) (Collection, error) {
+	return Collection{ID: id}, nil
+}
+
+func (t TestCollectionAPI) list(params PageParams) (CollectionList, error) {
+	return CollectionList{}, nil
+}
+
+func (t TestCollectionAPI) create(collection *Collection) (Collection, error) {
+	return *collection, nil
+}
+
+func (t TestCollectionAPI) update(id string, collection *Collection) (Collection, error) {
+	return *collection, nil
+}
+
+func (t TestCollectionAPI) delete(id string) error {
+	return nil
+}
+
+// TestHelpCenterHTTPClient answers requests with the fixture for their URI,
+// recording request bodies by URI.
+type TestHelpCenterHTTPClient struct {
+	TestHTTPClient
+	t        *testing.T
+	fixtures map[string]string
+	requests map[string]interface{}
+}
+
+func (t *TestHelpCenterHTTPClient) Get(uri string, params interface{}) ([]byte, error) {
+	return t.fixture(uri)
+}
+
+func (t *TestHelpCenterHTTPClient) Post(uri string, body interface{}) ([]byte, error) {
+	if t.requests == nil {
+		t.requests = map[string]interface{}{}
+	}
+	t.requests[uri] = body
+	return t.fixture(uri)
+}
+
+func (t *TestHelpCenterHTTPClient) fixture(uri string) ([]byte, error) {
+	fixtureFilename, ok := t.fixtures[uri]
+	if !ok {
+		t.t.Fatalf("Unexpected request to %s", uri)
+	}
+	return ioutil.ReadFile(fixtureFilename)
+}
@@ -48,7 +48,8 @@ type Plan struct {
 	Name string `json:"name,omitempty"`
 }
 
-type companyListParams struct {
+// CompanyListParams are the query parameters for listing Companies.
+type CompanyListParams struct {
 	PageParams
 	SegmentID string `url:"segment_id,omitempty"`
 	TagID     string `url:"tag_id,omitempty"`
@@ -56,6 +57,9 @@ type companyListParams struct {
 
 // FindByID finds a Company using their Intercom ID
 func (c *CompanyService) FindByID(id string) (Company, error) {
+	if id == "" {
+		return Company{}, missing("Company ID")
+	}
 	return c.findWithIdentifiers(CompanyIdentifiers{ID: id})
 }
 
@@ -71,32 +75,56 @@ func (c *CompanyService) FindByName(name string) (Company, error) {
 }
 
 func (c *CompanyService) findWithIdentifiers(identifiers CompanyIdentifiers) (Company, error) {
-	return c.Repository.find(identifiers)
+	return c.Repository.Find(identifiers)
 }
 
 // List Companies
 func (c *CompanyService) List(params PageParams) (CompanyList, error) {
-	return c.Repository.list(companyListParams{PageParams: params})
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return CompanyList{}, err
+	}
+	return c.Repository.List(CompanyListParams{PageParams: params})
+}
+
+// All returns a Pager over every Company for App, starting at the page params.
+func (c *CompanyService) All(params PageParams) *Pager[Company] {
+	return NewPager(params, func(params PageParams) ([]Company, PageParams, error) {
+		params, err := params.limit(MaxPerPage)
+		if err != nil {
+			return nil, PageParams{}, err
+		}
+		companyList, err := c.Repository.List(CompanyListParams{PageParams: params})
+		return companyList.Companies, companyList.Pages, err
+	})
 }
 
 // List Companies by Segment
 func (c *CompanyService) ListBySegment(segmentID string, params PageParams) (CompanyList, error) {
-	return c.Repository.list(companyListParams{PageParams: params, SegmentID: segmentID})
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return CompanyList{}, err
+	}
+	return c.Repository.List(CompanyListParams{PageParams: params, SegmentID: segmentID})
 }
 
 // List Companies by Tag
 func (c *CompanyService) ListByTag(tagID string, params PageParams) (CompanyList, error) {
-	return c.Repository.list(companyListParams{PageParams: params, TagID: tagID})
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return CompanyList{}, err
+	}
+	return c.Repository.List(CompanyListParams{PageParams: params, TagID: tagID})
 }
 
 // List all Companies for App via Scroll API
 func (c *CompanyService) Scroll(scrollParam string) (CompanyList, error) {
-	return c.Repository.scroll(scrollParam)
+	return c.Repository.Scroll(scrollParam)
 }
 
 // Save a new Company, or update an existing one.
 func (c *CompanyService) Save(user *Company) (Company, error) {
-	return c.Repository.save(user)
+	return c.Repository.Save(user)
 }
 
 func (c Company) String() string {
@@ -1,19 +1,24 @@
 package intercom
 
 import (
-	"encoding/json"
-	"errors"
 	"fmt"
 
 	"gopkg.in/intercom/intercom-go.v2/interfaces"
 )
 
 // CompanyRepository defines the interface for working with Companies through the API.
+// It can be implemented outside this package and set on the CompanyService;
+// intercomtest.RunCompanyRepositoryTests checks an implementation behaves as CompanyAPI does.
 type CompanyRepository interface {
-	find(CompanyIdentifiers) (Company, error)
-	list(companyListParams) (CompanyList, error)
-	scroll(scrollParam string) (CompanyList, error)
-	save(*Company) (Company, error)
+	// Find returns the Company with the identifiers' ID, or else its CompanyID or Name, or an error matching ErrNotFound.
+	Find(CompanyIdentifiers) (Company, error)
+	// List returns a page of Companies, in the segment or with the tag if the params have one.
+	List(CompanyListParams) (CompanyList, error)
+	// Scroll returns the next batch of Companies after the scroll param, or the first if it's empty,
+	// with the scroll param for the batch after.
+	Scroll(scrollParam string) (CompanyList, error)
+	// Save creates or updates the Company, by its CompanyID, and returns it as saved.
+	Save(*Company) (Company, error)
 }
 
 // CompanyAPI implements CompanyRepository
@@ -31,48 +36,51 @@ type requestCompany struct {
 	CustomAttributes map[string]interface{} `json:"custom_attributes,omitempty"`
 }
 
-func (api CompanyAPI) find(params CompanyIdentifiers) (Company, error) {
+func (api CompanyAPI) Find(params CompanyIdentifiers) (Company, error) {
 	company := Company{}
-	data, err := api.getClientForFind(params)
+	uri, data, err := api.getClientForFind(params)
 	if err != nil {
 		return company, err
 	}
-	err = json.Unmarshal(data, &company)
+	err = unmarshal(uri, data, &company)
 	return company, err
 }
 
-func (api CompanyAPI) getClientForFind(params CompanyIdentifiers) ([]byte, error) {
+func (api CompanyAPI) getClientForFind(params CompanyIdentifiers) (string, []byte, error) {
 	switch {
 	case params.ID != "":
-		return api.httpClient.Get(fmt.Sprintf("/companies/%s", params.ID), nil)
+		uri := fmt.Sprintf("/companies/%s", params.ID)
+		data, err := api.httpClient.Get(uri, nil)
+		return uri, data, err
 	case params.CompanyID != "", params.Name != "":
-		return api.httpClient.Get("/companies", params)
+		data, err := api.httpClient.Get("/companies", params)
+		return "/companies", data, err
 	}
-	return nil, errors.New("Missing Company Identifier")
+	return "", nil, missing("Company Identifier")
 }
 
-func (api CompanyAPI) list(params companyListParams) (CompanyList, error) {
+func (api CompanyAPI) List(params CompanyListParams) (CompanyList, error) {
 	companyList := CompanyList{}
 	data, err := api.httpClient.Get("/companies", params)
 	if err != nil {
 		return companyList, err
 	}
-	err = json.Unmarshal(data, &companyList)
+	err = unmarshal("/companies", data, &companyList)
 	return companyList, err
 }
 
-func (api CompanyAPI) scroll(scrollParam string) (CompanyList, error) {
+func (api CompanyAPI) Scroll(scrollParam string) (CompanyList, error) {
 	companyList := CompanyList{}
 	params := scrollParams{ScrollParam: scrollParam }
 	data, err := api.httpClient.Get("/companies/scroll", params)
 	if err != nil {
 		return companyList, err
 	}
-	err = json.Unmarshal(data, &companyList)
+	err = unmarshal("/companies/scroll", data, &companyList)
 	return companyList, err
 }
 
-func (api CompanyAPI) save(company *Company) (Company, error) {
+func (api CompanyAPI) Save(company *Company) (Company, error) {
 	requestCompany := requestCompany{
 		ID:               company.ID,
 		Name:             company.Name,
@@ -88,7 +96,7 @@ func (api CompanyAPI) save(company *Company) (Company, error) {
 	if err != nil {
 		return savedCompany, err
 	}
-	err = json.Unmarshal(data, &savedCompany)
+	err = unmarshal("/companies", data, &savedCompany)
 	return savedCompany, err
 }
 
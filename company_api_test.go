@@ -6,9 +6,9 @@ import (
 )
 
 func TestCompanyAPIFind(t *testing.T) {
-	http := TestCompanyHTTPClient{fixtureFilename: "fixtures/company.json", expectedURI: "/companies/54c42e7ea7a765fa7", t: t}
+	http := TestCompanyHTTPClient{fixtureFilename: "intercomtest/fixtures/company.json", expectedURI: "/companies/54c42e7ea7a765fa7", t: t}
 	api := CompanyAPI{httpClient: &http}
-	company, err := api.find(CompanyIdentifiers{ID: "54c42e7ea7a765fa7"})
+	company, err := api.Find(CompanyIdentifiers{ID: "54c42e7ea7a765fa7"})
 	if err != nil {
 		t.Errorf("Error parsing fixture %s", err)
 	}
@@ -27,18 +27,18 @@ func TestCompanyAPIFind(t *testing.T) {
 }
 
 func TestCompanyAPIFindByName(t *testing.T) {
-	http := TestCompanyHTTPClient{fixtureFilename: "fixtures/company.json", expectedURI: "/companies", t: t}
+	http := TestCompanyHTTPClient{fixtureFilename: "intercomtest/fixtures/company.json", expectedURI: "/companies", t: t}
 	api := CompanyAPI{httpClient: &http}
-	company, _ := api.find(CompanyIdentifiers{Name: "Important Company"})
+	company, _ := api.Find(CompanyIdentifiers{Name: "Important Company"})
 	if company.Name != "Important Company" {
 		t.Errorf("Name was %s, expected Important Company", company.Name)
 	}
 }
 
 func TestCompanyAPIListDefault(t *testing.T) {
-	http := TestCompanyHTTPClient{fixtureFilename: "fixtures/companies.json", expectedURI: "/companies", t: t}
+	http := TestCompanyHTTPClient{fixtureFilename: "intercomtest/fixtures/companies.json", expectedURI: "/companies", t: t}
 	api := CompanyAPI{httpClient: &http}
-	companyList, _ := api.list(companyListParams{})
+	companyList, _ := api.List(CompanyListParams{})
 	companies := companyList.Companies
 	if companies[0].ID != "54c42ed71623d8caa" {
 		t.Errorf("ID was %s, expected 54c42ed71623d8caa", companies[0].ID)
@@ -53,7 +53,7 @@ func TestCompanyAPISave(t *testing.T) {
 	http := TestCompanyHTTPClient{t: t, expectedURI: "/companies"}
 	api := CompanyAPI{httpClient: &http}
 	company := Company{CompanyID: "27"}
-	api.save(&company)
+	api.Save(&company)
 }
 
 type TestCompanyHTTPClient struct {
@@ -43,19 +43,19 @@ type TestCompanyAPI struct {
 	t *testing.T
 }
 
-func (t TestCompanyAPI) find(params CompanyIdentifiers) (Company, error) {
+func (t TestCompanyAPI) Find(params CompanyIdentifiers) (Company, error) {
 	return Company{ID: params.ID, Name: params.Name, CompanyID: params.CompanyID}, nil
 }
 
-func (t TestCompanyAPI) list(params companyListParams) (CompanyList, error) {
+func (t TestCompanyAPI) List(params CompanyListParams) (CompanyList, error) {
 	return CompanyList{Companies: []Company{Company{ID: "46adad3f09126dca", Name: "My Co", CompanyID: "aa123"}}}, nil
 }
 
-func (t TestCompanyAPI) scroll(scrollParam string) (CompanyList, error) {
+func (t TestCompanyAPI) Scroll(scrollParam string) (CompanyList, error) {
 	return CompanyList{Companies: []Company{Company{ID: "46adad3f09126dca", Name: "My Co", CompanyID: "aa123"}}}, nil
 }
 
-func (t TestCompanyAPI) save(company *Company) (Company, error) {
+func (t TestCompanyAPI) Save(company *Company) (Company, error) {
 	if company.ID != "46adad3f09126dca" {
 		t.t.Errorf("Company ID was %s, expected 46adad3f09126dca", company.ID)
 	}
@@ -50,6 +50,9 @@ type contactListParams struct {
 
 // FindByID looks up a Contact by their Intercom ID.
 func (c *ContactService) FindByID(id string) (Contact, error) {
+	if id == "" {
+		return Contact{}, missing("Contact ID")
+	}
 	return c.findWithIdentifiers(UserIdentifiers{ID: id})
 }
 
@@ -62,9 +65,25 @@ func (c *ContactService) findWithIdentifiers(identifiers UserIdentifiers) (Conta
 	return c.Repository.find(identifiers)
 }
 
-// List all Contacts for App.
+// List all Contacts for App. Pass Pages.Next.StartingAfter from the previous page as StartingAfter for the next.
 func (c *ContactService) List(params PageParams) (ContactList, error) {
-	return c.Repository.list(contactListParams{PageParams: params})
+	params, err := params.limit(MaxCursorPerPage)
+	if err != nil {
+		return ContactList{}, err
+	}
+	return c.Repository.list(contactListParams{PageParams: params.cursor()})
+}
+
+// All returns a Pager over every Contact for App, starting at the page params.
+func (c *ContactService) All(params PageParams) *Pager[Contact] {
+	return NewPager(params, func(params PageParams) ([]Contact, PageParams, error) {
+		params, err := params.limit(MaxCursorPerPage)
+		if err != nil {
+			return nil, PageParams{}, err
+		}
+		contactList, err := c.Repository.list(contactListParams{PageParams: params.cursor()})
+		return contactList.Contacts, contactList.Pages, err
+	})
 }
 
 // List all Contacts for App via Scroll API
@@ -74,17 +93,29 @@ func (c *ContactService) Scroll(scrollParam string) (ContactList, error) {
 
 // ListByEmail looks up a list of Contacts by their Email.
 func (c *ContactService) ListByEmail(email string, params PageParams) (ContactList, error) {
-	return c.Repository.list(contactListParams{PageParams: params, Email: email})
+	params, err := params.limit(MaxCursorPerPage)
+	if err != nil {
+		return ContactList{}, err
+	}
+	return c.Repository.list(contactListParams{PageParams: params.cursor(), Email: email})
 }
 
 // List Contacts by Segment.
 func (c *ContactService) ListBySegment(segmentID string, params PageParams) (ContactList, error) {
-	return c.Repository.list(contactListParams{PageParams: params, SegmentID: segmentID})
+	params, err := params.limit(MaxCursorPerPage)
+	if err != nil {
+		return ContactList{}, err
+	}
+	return c.Repository.list(contactListParams{PageParams: params.cursor(), SegmentID: segmentID})
 }
 
 // List Contacts By Tag.
 func (c *ContactService) ListByTag(tagID string, params PageParams) (ContactList, error) {
-	return c.Repository.list(contactListParams{PageParams: params, TagID: tagID})
+	params, err := params.limit(MaxCursorPerPage)
+	if err != nil {
+		return ContactList{}, err
+	}
+	return c.Repository.list(contactListParams{PageParams: params.cursor(), TagID: tagID})
 }
 
 // Create Contact
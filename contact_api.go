@@ -1,8 +1,6 @@
 package intercom
 
 import (
-	"encoding/json"
-	"errors"
 	"fmt"
 
 	"gopkg.in/intercom/intercom-go.v2/interfaces"
@@ -25,17 +23,21 @@ type ContactAPI struct {
 }
 
 func (api ContactAPI) find(params UserIdentifiers) (Contact, error) {
-	return unmarshalToContact(api.getClientForFind(params))
+	uri, data, err := api.getClientForFind(params)
+	return unmarshalToContact(uri, data, err)
 }
 
-func (api ContactAPI) getClientForFind(params UserIdentifiers) ([]byte, error) {
+func (api ContactAPI) getClientForFind(params UserIdentifiers) (string, []byte, error) {
 	switch {
 	case params.ID != "":
-		return api.httpClient.Get(fmt.Sprintf("/contacts/%s", params.ID), nil)
+		uri := fmt.Sprintf("/contacts/%s", params.ID)
+		data, err := api.httpClient.Get(uri, nil)
+		return uri, data, err
 	case params.UserID != "":
-		return api.httpClient.Get("/contacts", params)
+		data, err := api.httpClient.Get("/contacts", params)
+		return "/contacts", data, err
 	}
-	return nil, errors.New("Missing Contact Identifier")
+	return "", nil, missing("Contact Identifier")
 }
 
 func (api ContactAPI) list(params contactListParams) (ContactList, error) {
@@ -44,7 +46,7 @@ func (api ContactAPI) list(params contactListParams) (ContactList, error) {
 	if err != nil {
 		return contactList, err
 	}
-	err = json.Unmarshal(data, &contactList)
+	err = unmarshal("/contacts", data, &contactList)
 	return contactList, err
 }
 
@@ -55,18 +57,20 @@ func (api ContactAPI) scroll(scrollParam string) (ContactList, error) {
        if err != nil {
                return contactList, err
        }
-       err = json.Unmarshal(data, &contactList)
+       err = unmarshal("/contacts/scroll", data, &contactList)
        return contactList, err
 }
 
 func (api ContactAPI) create(contact *Contact) (Contact, error) {
 	requestContact := api.buildRequestContact(contact)
-	return unmarshalToContact(api.httpClient.Post("/contacts", &requestContact))
+	data, err := api.httpClient.Post("/contacts", &requestContact)
+	return unmarshalToContact("/contacts", data, err)
 }
 
 func (api ContactAPI) update(contact *Contact) (Contact, error) {
 	requestContact := api.buildRequestContact(contact)
-	return unmarshalToContact(api.httpClient.Post("/contacts", &requestContact))
+	data, err := api.httpClient.Post("/contacts", &requestContact)
+	return unmarshalToContact("/contacts", data, err)
 }
 
 func (api ContactAPI) convert(contact *Contact, user *User) (User, error) {
@@ -76,16 +80,18 @@ func (api ContactAPI) convert(contact *Contact, user *User) (User, error) {
 		Email:      user.Email,
 		SignedUpAt: user.SignedUpAt,
 	}}
-	return unmarshalToUser(api.httpClient.Post("/contacts/convert", &cr))
+	data, err := api.httpClient.Post("/contacts/convert", &cr)
+	return unmarshalToUser("/contacts/convert", data, err)
 }
 
 func (api ContactAPI) delete(id string) (Contact, error) {
 	contact := Contact{}
-	data, err := api.httpClient.Delete(fmt.Sprintf("/contacts/%s", id), nil)
+	uri := fmt.Sprintf("/contacts/%s", id)
+	data, err := api.httpClient.Delete(uri, nil)
 	if err != nil {
 		return contact, err
 	}
-	err = json.Unmarshal(data, &contact)
+	err = unmarshalAccepted(uri, data, &contact)
 	return contact, err
 }
 
@@ -94,12 +100,12 @@ type convertRequest struct {
 	Contact requestUser `json:"contact"`
 }
 
-func unmarshalToContact(data []byte, err error) (Contact, error) {
+func unmarshalToContact(uri string, data []byte, err error) (Contact, error) {
 	savedContact := Contact{}
 	if err != nil {
 		return savedContact, err
 	}
-	err = json.Unmarshal(data, &savedContact)
+	err = unmarshal(uri, data, &savedContact)
 	return savedContact, err
 }
 
@@ -3,7 +3,7 @@ package intercom
 import "testing"
 
 func TestContactAPIFind(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/contact.json", expectedURI: "/contacts/54c42e7ea7a765fa7", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/contact.json", expectedURI: "/contacts/54c42e7ea7a765fa7", t: t}
 	api := ContactAPI{httpClient: &http}
 	contact, err := api.find(UserIdentifiers{ID: "54c42e7ea7a765fa7"})
 	if err != nil {
@@ -21,7 +21,7 @@ func TestContactAPIFind(t *testing.T) {
 }
 
 func TestContactAPIListDefault(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/contacts.json", expectedURI: "/contacts", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/contacts.json", expectedURI: "/contacts", t: t}
 	api := ContactAPI{httpClient: &http}
 	contactList, _ := api.list(contactListParams{})
 	contacts := contactList.Contacts
@@ -32,10 +32,13 @@ func TestContactAPIListDefault(t *testing.T) {
 	if pages.Page != 1 {
 		t.Errorf("Page was %d, expected 1", pages.Page)
 	}
+	if pages.Next == nil || pages.Next.Page != 2 {
+		t.Errorf("Next was %+v, expected page 2", pages.Next)
+	}
 }
 
 func TestContactAPIListByEmail(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/contacts.json", expectedURI: "/contacts", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/contacts.json", expectedURI: "/contacts", t: t}
 	api := ContactAPI{httpClient: &http}
 	contactList, _ := api.list(contactListParams{Email: "mycontact@example.io"})
 	contacts := contactList.Contacts
@@ -52,21 +55,21 @@ func TestContactAPIListByEmail(t *testing.T) {
 }
 
 func TestContactAPICreate(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/contact.json", expectedURI: "/contacts", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/contact.json", expectedURI: "/contacts", t: t}
 	api := ContactAPI{httpClient: &http}
 	contact := &Contact{Email: "mycontact@example.io"}
 	api.create(contact)
 }
 
 func TestContactAPIUpdate(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/contact.json", expectedURI: "/contacts", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/contact.json", expectedURI: "/contacts", t: t}
 	api := ContactAPI{httpClient: &http}
 	contact := &Contact{UserID: "123", Email: "mycontact@example.io"}
 	api.update(contact)
 }
 
 func TestContactAPIConvert(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/user.json", expectedURI: "/contacts/convert", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/user.json", expectedURI: "/contacts/convert", t: t}
 	api := ContactAPI{httpClient: &http}
 	contact := &Contact{UserID: "abc", Email: "mycontact@example.io"}
 	user := &User{UserID: "123"}
@@ -77,7 +80,7 @@ func TestContactAPIConvert(t *testing.T) {
 }
 
 func TestContactAPIDelete(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/contact.json", expectedURI: "/contacts/b123d", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/contact.json", expectedURI: "/contacts/b123d", t: t}
 	api := ContactAPI{httpClient: &http}
 	contact := &Contact{ID: "b123d"}
 	returned, _ := api.delete(contact.ID)
@@ -93,13 +93,67 @@ const (
 
 // List all Conversations
 func (c *ConversationService) ListAll(pageParams PageParams) (ConversationList, error) {
-	return c.Repository.list(conversationListParams{PageParams: pageParams})
+	pageParams, err := pageParams.limit(MaxConversationsPerPage)
+	if err != nil {
+		return ConversationList{}, err
+	}
+	return c.Repository.List(ConversationListParams{PageParams: pageParams.cursor()})
+}
+
+// All returns a Pager over every Conversation, starting at the page params.
+// Unlike ListAll, which lists a single page, it fetches every page as it's needed.
+func (c *ConversationService) All(pageParams PageParams) *Pager[Conversation] {
+	return NewPager(pageParams, func(pageParams PageParams) ([]Conversation, PageParams, error) {
+		pageParams, err := pageParams.limit(MaxConversationsPerPage)
+		if err != nil {
+			return nil, PageParams{}, err
+		}
+		convoList, err := c.Repository.List(ConversationListParams{PageParams: pageParams.cursor()})
+		return convoList.Conversations, convoList.Pages, err
+	})
+}
+
+// StreamAll calls fn with every Conversation, starting at the page params and fetching each page as it's needed.
+// Conversations are decoded one at a time as each page is read, and not kept after fn returns,
+// so memory use stays flat however many Conversations, and parts, a page has. It stops at the first error,
+// from the API or returned by fn.
+func (c *ConversationService) StreamAll(pageParams PageParams, fn func(Conversation) error) error {
+	pageParams, err := pageParams.limit(MaxConversationsPerPage)
+	if err != nil {
+		return err
+	}
+	for {
+		count := 0
+		pages, err := c.stream(ConversationListParams{PageParams: pageParams.cursor()}, func(convo Conversation) error {
+			count++
+			return fn(convo)
+		})
+		if err != nil {
+			return err
+		}
+		next, done := nextPage(pageParams, pages)
+		if done || count == 0 {
+			return nil
+		}
+		pageParams = next
+	}
+}
+
+func (c *ConversationService) stream(params ConversationListParams, fn func(Conversation) error) (PageParams, error) {
+	if streamRepository, ok := c.Repository.(ConversationStreamRepository); ok {
+		return streamRepository.Stream(params, fn)
+	}
+	return listConversations(c.Repository, params, fn)
 }
 
 // List Conversations by Admin
 func (c *ConversationService) ListByAdmin(adminID string, orderBy string, sort string, state ConversationListState, pageParams PageParams) (ConversationList, error) {
-	params := conversationListParams{
-		PageParams: pageParams,
+	pageParams, err := pageParams.limit(MaxConversationsPerPage)
+	if err != nil {
+		return ConversationList{}, err
+	}
+	params := ConversationListParams{
+		PageParams: pageParams.cursor(),
 		Type:       "admin",
 		AdminID:    adminID,
 		Order:      orderBy,
@@ -113,13 +167,17 @@ func (c *ConversationService) ListByAdmin(adminID string, orderBy string, sort s
 		params.Open = Bool(false)
 		params.State = "closed"
 	}
-	return c.Repository.list(params)
+	return c.Repository.List(params)
 }
 
 // List Conversations by User
 func (c *ConversationService) ListByUser(user *User, state ConversationListState, pageParams PageParams) (ConversationList, error) {
-	params := conversationListParams{
-		PageParams:     pageParams,
+	pageParams, err := pageParams.limit(MaxConversationsPerPage)
+	if err != nil {
+		return ConversationList{}, err
+	}
+	params := ConversationListParams{
+		PageParams:     pageParams.cursor(),
 		Type:           "user",
 		IntercomUserID: user.ID,
 		UserID:         user.UserID,
@@ -128,17 +186,23 @@ func (c *ConversationService) ListByUser(user *User, state ConversationListState
 	if state == SHOW_UNREAD {
 		params.Unread = Bool(true)
 	}
-	return c.Repository.list(params)
+	return c.Repository.List(params)
 }
 
 // Find Conversation by conversation id
 func (c *ConversationService) Find(id string) (Conversation, error) {
-	return c.Repository.find(id)
+	if id == "" {
+		return Conversation{}, missing("Conversation ID")
+	}
+	return c.Repository.Find(id)
 }
 
 // Mark Conversation as read (by a User)
 func (c *ConversationService) MarkRead(id string) (Conversation, error) {
-	return c.Repository.read(id)
+	if id == "" {
+		return Conversation{}, missing("Conversation ID")
+	}
+	return c.Repository.Read(id)
 }
 
 func (c *ConversationService) Reply(id string, author MessagePerson, replyType ReplyType, body string) (Conversation, error) {
@@ -151,25 +215,24 @@ func (c *ConversationService) ReplyWithAttachmentURLs(id string, author MessageP
 }
 
 func (c *ConversationService) reply(id string, author MessagePerson, replyType ReplyType, body string, attachmentURLs []string) (Conversation, error) {
-	addr := author.MessageAddress()
-	reply := Reply{
-		Type:           addr.Type,
-		ReplyType:      replyType.String(),
-		Body:           body,
-		AttachmentURLs: attachmentURLs,
+	if id == "" {
+		return Conversation{}, missing("Conversation ID")
 	}
-	if addr.Type == "admin" {
-		reply.AdminID = addr.ID
-	} else {
-		reply.IntercomID = addr.ID
-		reply.UserID = addr.UserID
-		reply.Email = addr.Email
+	if !identified(author) {
+		return Conversation{}, missing("Reply Author Identifier")
 	}
-	return c.Repository.reply(id, &reply)
+	reply := newReply(author, replyType, body, attachmentURLs)
+	return c.Repository.Reply(id, &reply)
 }
 
 // Assign a Conversation to an Admin
 func (c *ConversationService) Assign(id string, assigner, assignee *Admin) (Conversation, error) {
+	if id == "" {
+		return Conversation{}, missing("Conversation ID")
+	}
+	if !identified(assigner) || !identified(assignee) {
+		return Conversation{}, missing("Assigner or Assignee Identifier")
+	}
 	assignerAddr := assigner.MessageAddress()
 	assigneeAddr := assignee.MessageAddress()
 	reply := Reply{
@@ -178,7 +241,7 @@ func (c *ConversationService) Assign(id string, assigner, assignee *Admin) (Conv
 		AdminID:    assignerAddr.ID,
 		AssigneeID: assigneeAddr.ID,
 	}
-	return c.Repository.reply(id, &reply)
+	return c.Repository.Reply(id, &reply)
 }
 
 // Open a Conversation (without a body)
@@ -191,7 +254,8 @@ func (c *ConversationService) Close(id string, closer *Admin) (Conversation, err
 	return c.reply(id, closer, CONVERSATION_CLOSE, "", nil)
 }
 
-type conversationListParams struct {
+// ConversationListParams are the query parameters for listing Conversations, by Admin or User.
+type ConversationListParams struct {
 	PageParams
 	Type           string `url:"type,omitempty"`
 	AdminID        string `url:"admin_id,omitempty"`
@@ -3,19 +3,36 @@ package intercom
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 
 	"gopkg.in/intercom/intercom-go.v2/interfaces"
 )
 
 // ConversationRepository defines the interface for working with Conversations through the API.
+// It can be implemented outside this package, for caching, faking or reading from a mirror, and set on
+// the ConversationService; ConversationAPI is the reference implementation, and
+// intercomtest.RunConversationRepositoryTests checks another behaves as it does.
 type ConversationRepository interface {
-	find(id string) (Conversation, error)
-	list(params conversationListParams) (ConversationList, error)
-	read(id string) (Conversation, error)
-	reply(id string, reply *Reply) (Conversation, error)
+	// Find returns the Conversation with the id, with its parts, or an error matching ErrNotFound if there's none.
+	Find(id string) (Conversation, error)
+	// List returns a page of Conversations matching the params, with the paging information for the next.
+	List(params ConversationListParams) (ConversationList, error)
+	// Read marks the Conversation with the id as read, and returns it.
+	Read(id string) (Conversation, error)
+	// Reply adds the reply to the Conversation with the id, which may also assign, open or close it,
+	// and returns the Conversation as it is after.
+	Reply(id string, reply *Reply) (Conversation, error)
 }
 
-// ConversationAPI implements ConversationRepository
+// ConversationStreamRepository is a ConversationRepository which can also stream a page of Conversations,
+// calling fn with each as it's decoded rather than keeping them, and returning the page's paging information.
+// It is optional; ConversationService.StreamAll lists each page otherwise.
+type ConversationStreamRepository interface {
+	ConversationRepository
+	Stream(params ConversationListParams, fn func(Conversation) error) (PageParams, error)
+}
+
+// ConversationAPI implements ConversationRepository and ConversationStreamRepository.
 type ConversationAPI struct {
 	httpClient interfaces.HTTPClient
 }
@@ -24,42 +41,136 @@ type conversationReadRequest struct {
 	Read bool `json:"read"`
 }
 
-func (api ConversationAPI) list(params conversationListParams) (ConversationList, error) {
+func (api ConversationAPI) List(params ConversationListParams) (ConversationList, error) {
 	convoList := ConversationList{}
 	data, err := api.httpClient.Get("/conversations", params)
 	if err != nil {
 		return convoList, err
 	}
-	err = json.Unmarshal(data, &convoList)
+	err = unmarshal("/conversations", data, &convoList)
 	return convoList, err
 }
 
-func (api ConversationAPI) read(id string) (Conversation, error) {
+// Stream lists a page of Conversations, decoding them one at a time as the response is read,
+// when the HTTPClient can stream; otherwise it lists them as usual.
+func (api ConversationAPI) Stream(params ConversationListParams, fn func(Conversation) error) (PageParams, error) {
+	streamClient, ok := currentHTTPClient(api.httpClient).(interfaces.HTTPJSONStreamClient)
+	if !ok {
+		return listConversations(api, params, fn)
+	}
+	body, err := streamClient.GetJSONStream("/conversations", params)
+	if err != nil {
+		return PageParams{}, err
+	}
+	defer body.Close()
+	return decodeConversationStream(body, fn)
+}
+
+// listConversations calls fn with each Conversation of a page listed by the repository, for streaming without streaming.
+func listConversations(repository ConversationRepository, params ConversationListParams, fn func(Conversation) error) (PageParams, error) {
+	convoList, err := repository.List(params)
+	if err != nil {
+		return convoList.Pages, err
+	}
+	for _, convo := range convoList.Conversations {
+		if err := fn(convo); err != nil {
+			return convoList.Pages, err
+		}
+	}
+	return convoList.Pages, nil
+}
+
+// decodeConversationStream decodes a ConversationList from r, calling fn with each Conversation
+// as it's decoded rather than keeping them, and returns its paging information.
+func decodeConversationStream(r io.Reader, fn func(Conversation) error) (PageParams, error) {
+	pages := PageParams{}
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return pages, err
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return pages, err
+		}
+		switch key {
+		case "pages":
+			err = dec.Decode(&pages)
+		case "conversations":
+			err = decodeEach(dec, func() error {
+				convo := Conversation{}
+				if err := dec.Decode(&convo); err != nil {
+					return err
+				}
+				return fn(convo)
+			})
+		default:
+			err = dec.Decode(&json.RawMessage{})
+		}
+		if err != nil {
+			return pages, err
+		}
+	}
+	return pages, expectDelim(dec, '}')
+}
+
+// decodeEach calls decode for each item of the JSON array dec is at, which is null if empty.
+func decodeEach(dec *json.Decoder, decode func() error) error {
+	token, err := dec.Token()
+	if err != nil || token == nil {
+		return err
+	}
+	if token != json.Delim('[') {
+		return fmt.Errorf("Unexpected JSON %v, expected [", token)
+	}
+	for dec.More() {
+		if err := decode(); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("Unexpected JSON %v, expected %v", token, delim)
+	}
+	return nil
+}
+
+func (api ConversationAPI) Read(id string) (Conversation, error) {
 	conversation := Conversation{}
-	data, err := api.httpClient.Post(fmt.Sprintf("/conversations/%s", id), conversationReadRequest{Read: true})
+	uri := fmt.Sprintf("/conversations/%s", id)
+	data, err := api.httpClient.Post(uri, conversationReadRequest{Read: true})
 	if err != nil {
 		return conversation, err
 	}
-	err = json.Unmarshal(data, &conversation)
+	err = unmarshal(uri, data, &conversation)
 	return conversation, err
 }
 
-func (api ConversationAPI) reply(id string, reply *Reply) (Conversation, error) {
+func (api ConversationAPI) Reply(id string, reply *Reply) (Conversation, error) {
 	conversation := Conversation{}
-	data, err := api.httpClient.Post(fmt.Sprintf("/conversations/%s/reply", id), reply)
+	uri := fmt.Sprintf("/conversations/%s/reply", id)
+	data, err := api.httpClient.Post(uri, reply)
 	if err != nil {
 		return conversation, err
 	}
-	err = json.Unmarshal(data, &conversation)
+	err = unmarshal(uri, data, &conversation)
 	return conversation, nil
 }
 
-func (api ConversationAPI) find(id string) (Conversation, error) {
+func (api ConversationAPI) Find(id string) (Conversation, error) {
 	conversation := Conversation{}
-	data, err := api.httpClient.Get(fmt.Sprintf("/conversations/%s", id), nil)
+	uri := fmt.Sprintf("/conversations/%s", id)
+	data, err := api.httpClient.Get(uri, nil)
 	if err != nil {
 		return conversation, err
 	}
-	err = json.Unmarshal(data, &conversation)
+	err = unmarshal(uri, data, &conversation)
 	return conversation, err
 }
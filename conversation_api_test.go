@@ -1,14 +1,18 @@
 package intercom
 
 import (
+	"errors"
+	"io"
 	"io/ioutil"
+	"os"
+	"strings"
 	"testing"
 )
 
 func TestConversationFind(t *testing.T) {
-	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations/147", fixtureFilename: "fixtures/conversation.json"}
+	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations/147", fixtureFilename: "intercomtest/fixtures/conversation.json"}
 	api := ConversationAPI{httpClient: &http}
-	convo, _ := api.find("147")
+	convo, _ := api.Find("147")
 	if convo.ID != "147" {
 		t.Errorf("Conversation not retrieved, %s", convo.ID)
 	}
@@ -24,7 +28,7 @@ func TestConversationFind(t *testing.T) {
 }
 
 func TestConversationRead(t *testing.T) {
-	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations/147", fixtureFilename: "fixtures/conversation.json"}
+	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations/147", fixtureFilename: "intercomtest/fixtures/conversation.json"}
 	http.testFunc = func(t *testing.T, readRequest interface{}) {
 		req := readRequest.(conversationReadRequest)
 		if req.Read != true {
@@ -32,7 +36,7 @@ func TestConversationRead(t *testing.T) {
 		}
 	}
 	api := ConversationAPI{httpClient: &http}
-	convo, err := api.read("147")
+	convo, err := api.Read("147")
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -42,7 +46,7 @@ func TestConversationRead(t *testing.T) {
 }
 
 func TestConversationReply(t *testing.T) {
-	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations/147/reply", fixtureFilename: "fixtures/conversation.json"}
+	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations/147/reply", fixtureFilename: "intercomtest/fixtures/conversation.json"}
 	http.testFunc = func(t *testing.T, replyRequest interface{}) {
 		reply := replyRequest.(*Reply)
 		if reply.ReplyType != CONVERSATION_NOTE.String() {
@@ -50,7 +54,7 @@ func TestConversationReply(t *testing.T) {
 		}
 	}
 	api := ConversationAPI{httpClient: &http}
-	convo, err := api.reply("147", &Reply{ReplyType: CONVERSATION_NOTE.String(), AdminID: "123"})
+	convo, err := api.Reply("147", &Reply{ReplyType: CONVERSATION_NOTE.String(), AdminID: "123"})
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -60,7 +64,7 @@ func TestConversationReply(t *testing.T) {
 }
 
 func TestConversationReplyWithAttachment(t *testing.T) {
-	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations/147/reply", fixtureFilename: "fixtures/conversation.json"}
+	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations/147/reply", fixtureFilename: "intercomtest/fixtures/conversation.json"}
 	http.testFunc = func(t *testing.T, replyRequest interface{}) {
 		reply := replyRequest.(*Reply)
 		if reply.ReplyType != CONVERSATION_COMMENT.String() {
@@ -68,7 +72,7 @@ func TestConversationReplyWithAttachment(t *testing.T) {
 		}
 	}
 	api := ConversationAPI{httpClient: &http}
-	convo, err := api.reply("147", &Reply{ReplyType: CONVERSATION_COMMENT.String(), AdminID: "123", AttachmentURLs: []string{"http://www.example.com/attachment.jpg"}})
+	convo, err := api.Reply("147", &Reply{ReplyType: CONVERSATION_COMMENT.String(), AdminID: "123", AttachmentURLs: []string{"http://www.example.com/attachment.jpg"}})
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -78,9 +82,9 @@ func TestConversationReplyWithAttachment(t *testing.T) {
 }
 
 func TestConversationListAll(t *testing.T) {
-	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations", fixtureFilename: "fixtures/conversations.json"}
+	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations", fixtureFilename: "intercomtest/fixtures/conversations.json"}
 	api := ConversationAPI{httpClient: &http}
-	convos, _ := api.list(conversationListParams{})
+	convos, _ := api.List(ConversationListParams{})
 	if convos.Conversations[0].ID != "147" {
 		t.Errorf("Conversation not retrieved")
 	}
@@ -99,27 +103,105 @@ func TestConversationListAll(t *testing.T) {
 }
 
 func TestConversationListUserUnread(t *testing.T) {
-	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations", fixtureFilename: "fixtures/conversations.json"}
+	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations", fixtureFilename: "intercomtest/fixtures/conversations.json"}
 	http.testFunc = func(t *testing.T, queryParams interface{}) {
-		ps := queryParams.(conversationListParams)
+		ps := queryParams.(ConversationListParams)
 		if *ps.Unread != true {
 			t.Errorf("Expect unread parameter, got %v", *ps.Unread)
 		}
 	}
 	api := ConversationAPI{httpClient: &http}
-	api.list(conversationListParams{Unread: Bool(true)})
+	api.List(ConversationListParams{Unread: Bool(true)})
 }
 
 func TestConversationListAdminOpen(t *testing.T) {
-	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations", fixtureFilename: "fixtures/conversations.json"}
+	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations", fixtureFilename: "intercomtest/fixtures/conversations.json"}
 	http.testFunc = func(t *testing.T, queryParams interface{}) {
-		ps := queryParams.(conversationListParams)
+		ps := queryParams.(ConversationListParams)
 		if *ps.Open != true {
 			t.Errorf("Expect open parameter, got %v", *ps.Unread)
 		}
 	}
 	api := ConversationAPI{httpClient: &http}
-	api.list(conversationListParams{Open: Bool(true)})
+	api.List(ConversationListParams{Open: Bool(true)})
+}
+
+func TestConversationStream(t *testing.T) {
+	http := TestConversationStreamHTTPClient{TestConversationHTTPClient{t: t, expectedURI: "/conversations", fixtureFilename: "intercomtest/fixtures/conversations.json"}}
+	api := ConversationAPI{httpClient: &http}
+	convos := []Conversation{}
+	_, err := api.Stream(ConversationListParams{}, func(convo Conversation) error {
+		convos = append(convos, convo)
+		return nil
+	})
+	if err != nil || len(convos) != 1 || convos[0].ID != "147" {
+		t.Fatalf("streamed %v, %v, expected Conversation 147", convos, err)
+	}
+	if parts := convos[0].ConversationParts.Parts; len(parts) != 1 || parts[0].ID != "4412" {
+		t.Errorf("parts were %v, expected part 4412", parts)
+	}
+}
+
+func TestConversationStreamFallback(t *testing.T) {
+	http := TestConversationHTTPClient{t: t, expectedURI: "/conversations", fixtureFilename: "intercomtest/fixtures/conversations.json"}
+	api := ConversationAPI{httpClient: &http}
+	count := 0
+	api.Stream(ConversationListParams{}, func(convo Conversation) error {
+		count++
+		return nil
+	})
+	if count != 1 {
+		t.Errorf("streamed %d Conversations, expected 1", count)
+	}
+}
+
+func TestDecodeConversationStream(t *testing.T) {
+	body := `{"type": "conversation.list", "conversations": [{"id": "1", "tags": {"tags": []}}, {"id": "2"}, {"id": "3"}],
+		"pages": {"type": "pages", "page": 1, "per_page": 3, "total_pages": 4, "next": {"page": 2, "starting_after": "WzM="}}}`
+	ids := []string{}
+	pages, err := decodeConversationStream(strings.NewReader(body), func(convo Conversation) error {
+		ids = append(ids, convo.ID)
+		return nil
+	})
+	if err != nil || strings.Join(ids, ",") != "1,2,3" {
+		t.Errorf("decoded %v, %v, expected 1,2,3", ids, err)
+	}
+	if pages.TotalPages != 4 || pages.Next == nil || pages.Next.StartingAfter != "WzM=" {
+		t.Errorf("pages were %+v", pages)
+	}
+
+	stop := errors.New("stop")
+	count := 0
+	_, err = decodeConversationStream(strings.NewReader(body), func(Conversation) error {
+		count++
+		return stop
+	})
+	if err != stop || count != 1 {
+		t.Errorf("err was %v after %d Conversations, expected to stop after 1", err, count)
+	}
+
+	for _, body := range []string{`{"conversations": null}`, `{"conversations": []}`} {
+		if _, err := decodeConversationStream(strings.NewReader(body), func(Conversation) error { return stop }); err != nil {
+			t.Errorf("Unexpected error decoding %s: %v", body, err)
+		}
+	}
+	if _, err := decodeConversationStream(strings.NewReader(`{"conversations": [{"id": "1"}`), func(Conversation) error { return nil }); err == nil {
+		t.Errorf("Expected an error decoding a truncated body")
+	}
+	if _, err := decodeConversationStream(strings.NewReader(`{"conversations": {}}`), func(Conversation) error { return nil }); err == nil {
+		t.Errorf("Expected an error decoding a body without a list")
+	}
+}
+
+type TestConversationStreamHTTPClient struct {
+	TestConversationHTTPClient
+}
+
+func (t *TestConversationStreamHTTPClient) GetJSONStream(uri string, queryParams interface{}) (io.ReadCloser, error) {
+	if t.expectedURI != uri {
+		t.t.Errorf("Wrong endpoint called")
+	}
+	return os.Open(t.fixtureFilename)
 }
 
 type TestConversationHTTPClient struct {
@@ -1,6 +1,9 @@
 package intercom
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestFindConversation(t *testing.T) {
 	conversationService := ConversationService{Repository: TestConversationAPI{t: t}}
@@ -99,11 +102,45 @@ func TestListAllConversations(t *testing.T) {
 	}
 }
 
+func TestListAllConversationsCursor(t *testing.T) {
+	testAPI := TestConversationAPI{t: t}
+	testAPI.testFunc = func(t *testing.T, params interface{}) {
+		pageParams := params.(ConversationListParams).PageParams
+		if pageParams.Page != 0 || pageParams.StartingAfter != "WzE2ODQ=" {
+			t.Errorf("page params were %+v, expected only a cursor", pageParams)
+		}
+	}
+	conversationService := ConversationService{Repository: testAPI}
+	conversationService.ListAll(PageParams{Page: 3, StartingAfter: "WzE2ODQ="})
+}
+
+func TestStreamAllConversations(t *testing.T) {
+	testAPI := TestConversationAPI{t: t}
+	pages := 0
+	testAPI.testFunc = func(t *testing.T, params interface{}) {
+		pages++
+	}
+	conversationService := ConversationService{Repository: testAPI}
+	ids := []string{}
+	err := conversationService.StreamAll(PageParams{}, func(convo Conversation) error {
+		ids = append(ids, convo.ID)
+		return nil
+	})
+	if err != nil || len(ids) != 1 || ids[0] != "123" || pages != 1 {
+		t.Errorf("streamed %v from %d pages, %v, expected 123 from 1", ids, pages, err)
+	}
+
+	stop := errors.New("stop")
+	if err := conversationService.StreamAll(PageParams{}, func(Conversation) error { return stop }); err != stop {
+		t.Errorf("err was %v, expected the callback's error", err)
+	}
+}
+
 func TestListUserConversationsUnread(t *testing.T) {
 	testAPI := TestConversationAPI{t: t}
 	testAPI.testFunc = func(t *testing.T, params interface{}) {
-		if *params.(conversationListParams).Unread != true {
-			t.Errorf("unread was %v, expected true", *params.(conversationListParams).Unread)
+		if *params.(ConversationListParams).Unread != true {
+			t.Errorf("unread was %v, expected true", *params.(ConversationListParams).Unread)
 		}
 	}
 	conversationService := ConversationService{Repository: testAPI}
@@ -117,8 +154,8 @@ func TestListUserConversationsUnread(t *testing.T) {
 func TestListUserConversationsAll(t *testing.T) {
 	testAPI := TestConversationAPI{t: t}
 	testAPI.testFunc = func(t *testing.T, params interface{}) {
-		if params.(conversationListParams).Unread != nil {
-			t.Errorf("unread was not nil, was %v", *params.(conversationListParams).Unread)
+		if params.(ConversationListParams).Unread != nil {
+			t.Errorf("unread was not nil, was %v", *params.(ConversationListParams).Unread)
 		}
 	}
 	conversationService := ConversationService{Repository: testAPI}
@@ -132,8 +169,8 @@ func TestListUserConversationsAll(t *testing.T) {
 func TestListAdminConversationsAll(t *testing.T) {
 	testAPI := TestConversationAPI{t: t}
 	testAPI.testFunc = func(t *testing.T, params interface{}) {
-		if params.(conversationListParams).Open != nil {
-			t.Errorf("open was not nil, was %v", *params.(conversationListParams).Open)
+		if params.(ConversationListParams).Open != nil {
+			t.Errorf("open was not nil, was %v", *params.(ConversationListParams).Open)
 		}
 	}
 	conversationService := ConversationService{Repository: testAPI}
@@ -146,8 +183,8 @@ func TestListAdminConversationsAll(t *testing.T) {
 func TestListAdminConversationsOpen(t *testing.T) {
 	testAPI := TestConversationAPI{t: t}
 	testAPI.testFunc = func(t *testing.T, params interface{}) {
-		if *params.(conversationListParams).Open != true {
-			t.Errorf("open was not true, was %v", *params.(conversationListParams).Open)
+		if *params.(ConversationListParams).Open != true {
+			t.Errorf("open was not true, was %v", *params.(ConversationListParams).Open)
 		}
 	}
 	conversationService := ConversationService{Repository: testAPI}
@@ -162,22 +199,22 @@ type TestConversationAPI struct {
 	t        *testing.T
 }
 
-func (t TestConversationAPI) list(params conversationListParams) (ConversationList, error) {
+func (t TestConversationAPI) List(params ConversationListParams) (ConversationList, error) {
 	if t.testFunc != nil {
 		t.testFunc(t.t, params)
 	}
 	return ConversationList{Conversations: []Conversation{Conversation{ID: "123"}}, Pages: PageParams{Page: 1, PerPage: 20}}, nil
 }
 
-func (t TestConversationAPI) find(id string) (Conversation, error) {
+func (t TestConversationAPI) Find(id string) (Conversation, error) {
 	return Conversation{ID: "123"}, nil
 }
 
-func (t TestConversationAPI) read(id string) (Conversation, error) {
+func (t TestConversationAPI) Read(id string) (Conversation, error) {
 	return Conversation{ID: "123"}, nil
 }
 
-func (t TestConversationAPI) reply(id string, reply *Reply) (Conversation, error) {
+func (t TestConversationAPI) Reply(id string, reply *Reply) (Conversation, error) {
 	if t.testFunc != nil {
 		t.testFunc(t.t, reply)
 	}
@@ -0,0 +1,92 @@
+package intercom
+
+import "fmt"
+
+// CountService handles interactions with the API through a CountRepository.
+type CountService struct {
+	Repository CountRepository
+}
+
+// AppCounts holds the totals of each resource in the App.
+type AppCounts struct {
+	Users     int64
+	Leads     int64
+	Companies int64
+	Tags      int64
+	Segments  int64
+}
+
+// ConversationCounts holds the totals of Conversations in the App, by state.
+type ConversationCounts struct {
+	Open       int64 `json:"open"`
+	Closed     int64 `json:"closed"`
+	Assigned   int64 `json:"assigned"`
+	Unassigned int64 `json:"unassigned"`
+}
+
+// AdminConversationCount holds the totals of open and closed Conversations for an Admin.
+type AdminConversationCount struct {
+	ID     ID     `json:"id"`
+	Name   string `json:"name"`
+	Open   int64  `json:"open"`
+	Closed int64  `json:"closed"`
+}
+
+// NamedCount holds the total for a named Segment, Tag or Company.
+type NamedCount struct {
+	Name  string
+	Count int64
+}
+
+type countParams struct {
+	Type  string `url:"type"`
+	Count string `url:"count,omitempty"`
+}
+
+// AppCounts gets the totals of each resource in the App.
+func (c *CountService) AppCounts() (AppCounts, error) {
+	return c.Repository.appCounts()
+}
+
+// ConversationCounts gets the totals of Conversations in the App, by state.
+func (c *CountService) ConversationCounts() (ConversationCounts, error) {
+	return c.Repository.conversationCounts()
+}
+
+// ConversationCountsByAdmin gets the totals of open and closed Conversations for each Admin.
+func (c *CountService) ConversationCountsByAdmin() ([]AdminConversationCount, error) {
+	return c.Repository.conversationCountsByAdmin()
+}
+
+// UserCountsBySegment gets the number of Users in each Segment.
+func (c *CountService) UserCountsBySegment() ([]NamedCount, error) {
+	return c.Repository.namedCounts(countParams{Type: "user", Count: "segment"})
+}
+
+// UserCountsByTag gets the number of Users with each Tag.
+func (c *CountService) UserCountsByTag() ([]NamedCount, error) {
+	return c.Repository.namedCounts(countParams{Type: "user", Count: "tag"})
+}
+
+// CompanyCountsBySegment gets the number of Companies in each Segment.
+func (c *CountService) CompanyCountsBySegment() ([]NamedCount, error) {
+	return c.Repository.namedCounts(countParams{Type: "company", Count: "segment"})
+}
+
+// CompanyCountsByTag gets the number of Companies with each Tag.
+func (c *CountService) CompanyCountsByTag() ([]NamedCount, error) {
+	return c.Repository.namedCounts(countParams{Type: "company", Count: "tag"})
+}
+
+// CompanyUserCounts gets the number of Users in each Company, by Company name.
+func (c *CountService) CompanyUserCounts() ([]NamedCount, error) {
+	return c.Repository.namedCounts(countParams{Type: "company", Count: "user"})
+}
+
+func (a AppCounts) String() string {
+	return fmt.Sprintf("[intercom] app_counts { users: %d, leads: %d, companies: %d, tags: %d, segments: %d }", a.Users, a.Leads, a.Companies, a.Tags, a.Segments)
+}
+
+func (n NamedCount) String() string {
+	return fmt.Sprintf("[intercom] count { name: %s, count: %d }", n.Name, n.Count)
+}
@@ -0,0 +1,127 @@
+package intercom
+
+import (
+	"encoding/json"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// CountRepository defines the interface for working with Counts through the API.
+type CountRepository interface {
+	appCounts() (AppCounts, error)
+	conversationCounts() (ConversationCounts, error)
+	conversationCountsByAdmin() ([]AdminConversationCount, error)
+	namedCounts(params countParams) ([]NamedCount, error)
+}
+
+// CountAPI implements CountRepository
+type CountAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+type countValue struct {
+	Count int64 `json:"count"`
+}
+
+type appCountsResponse struct {
+	User    countValue `json:"user"`
+	Lead    countValue `json:"lead"`
+	Company countValue `json:"company"`
+	Tag     countValue `json:"tag"`
+	Segment countValue `json:"segment"`
+}
+
+type conversationCountsResponse struct {
+	Conversation ConversationCounts `json:"conversation"`
+}
+
+type conversationAdminCountsResponse struct {
+	Conversation struct {
+		Admin []AdminConversationCount `json:"admin"`
+	} `json:"conversation"`
+}
+
+// namedCountList decodes the counts API's list of single-key objects,
+// such as [{"Active": 1}, {"New": 0}], keeping their order.
+// Counts may also be given as objects, such as [{"Important Company": {"count": 7}}].
+type namedCountList []NamedCount
+
+func (l *namedCountList) UnmarshalJSON(b []byte) error {
+	entries := []map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return err
+	}
+	*l = make(namedCountList, 0, len(entries))
+	for _, entry := range entries {
+		for name, raw := range entry {
+			namedCount := NamedCount{Name: name}
+			if err := json.Unmarshal(raw, &namedCount.Count); err != nil {
+				value := countValue{}
+				if err := json.Unmarshal(raw, &value); err != nil {
+					return err
+				}
+				namedCount.Count = value.Count
+			}
+			*l = append(*l, namedCount)
+		}
+	}
+	return nil
+}
+
+func (api CountAPI) appCounts() (AppCounts, error) {
+	response := appCountsResponse{}
+	data, err := api.httpClient.Get("/counts", nil)
+	if err != nil {
+		return AppCounts{}, err
+	}
+	err = unmarshal("/counts", data, &response)
+	return AppCounts{
+		Users:     response.User.Count,
+		Leads:     response.Lead.Count,
+		Companies: response.Company.Count,
+		Tags:      response.Tag.Count,
+		Segments:  response.Segment.Count,
+	}, err
+}
+
+func (api CountAPI) conversationCounts() (ConversationCounts, error) {
+	response := conversationCountsResponse{}
+	data, err := api.httpClient.Get("/counts", countParams{Type: "conversation"})
+	if err != nil {
+		return response.Conversation, err
+	}
+	err = unmarshal("/counts", data, &response)
+	return response.Conversation, err
+}
+
+func (api CountAPI) conversationCountsByAdmin() ([]AdminConversationCount, error) {
+	response := conversationAdminCountsResponse{}
+	data, err := api.httpClient.Get("/counts", countParams{Type: "conversation", Count: "admin"})
+	if err != nil {
+		return nil, err
+	}
+	err = unmarshal("/counts", data, &response)
+	return response.Conversation.Admin, err
+}
+
+func (api CountAPI) namedCounts(params countParams) ([]NamedCount, error) {
+	response := map[string]json.RawMessage{}
+	data, err := api.httpClient.Get("/counts", params)
+	if err != nil {
+		return nil, err
+	}
+	if err = unmarshal("/counts", data, &response); err != nil {
+		return nil, err
+	}
+	countsByName := map[string]namedCountList{}
+	if typeCounts, ok := response[params.Type]; ok {
+		if err = json.Unmarshal(typeCounts, &countsByName); err != nil {
+			return nil, err
+		}
+	}
+	counts := countsByName[params.Count]
+	if counts == nil {
+		return []NamedCount{}, nil
+	}
+	return counts, nil
+}
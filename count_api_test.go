@@ -0,0 +1,130 @@
+package intercom
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestCountAPIAppCounts(t *testing.T) {
+	http := TestCountHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/counts.json", expectedURI: "/counts"}
+	api := CountAPI{httpClient: &http}
+	counts, err := api.appCounts()
+	if err != nil {
+		t.Fatalf("Error getting counts: %v", err)
+	}
+	expected := AppCounts{Users: 10, Leads: 5, Companies: 8, Tags: 95, Segments: 36}
+	if counts != expected {
+		t.Errorf("Counts were %v, expected %v", counts, expected)
+	}
+}
+
+func TestCountAPIConversationCounts(t *testing.T) {
+	http := TestCountHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/conversation_counts.json", expectedURI: "/counts"}
+	api := CountAPI{httpClient: &http}
+	counts, err := api.conversationCounts()
+	if err != nil {
+		t.Fatalf("Error getting conversation counts: %v", err)
+	}
+	expected := ConversationCounts{Open: 12, Closed: 150, Assigned: 10, Unassigned: 2}
+	if counts != expected {
+		t.Errorf("Counts were %v, expected %v", counts, expected)
+	}
+	if params := http.lastQueryParams.(countParams); params.Type != "conversation" || params.Count != "" {
+		t.Errorf("Params were %v, expected type conversation", params)
+	}
+}
+
+func TestCountAPIConversationCountsByAdmin(t *testing.T) {
+	http := TestCountHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/conversation_admin_counts.json", expectedURI: "/counts"}
+	api := CountAPI{httpClient: &http}
+	counts, err := api.conversationCountsByAdmin()
+	if err != nil {
+		t.Fatalf("Error getting conversation counts by admin: %v", err)
+	}
+	expected := AdminConversationCount{ID: "2", Name: "Admin B", Open: 7, Closed: 79}
+	if len(counts) != 2 || counts[1] != expected {
+		t.Errorf("Counts were %v, expected second to be %v", counts, expected)
+	}
+	if params := http.lastQueryParams.(countParams); params.Type != "conversation" || params.Count != "admin" {
+		t.Errorf("Params were %v, expected type conversation, count admin", params)
+	}
+}
+
+func TestCountAPIUserCountsBySegment(t *testing.T) {
+	http := TestCountHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/user_segment_counts.json", expectedURI: "/counts"}
+	api := CountAPI{httpClient: &http}
+	counts, err := api.namedCounts(countParams{Type: "user", Count: "segment"})
+	if err != nil {
+		t.Fatalf("Error getting user counts by segment: %v", err)
+	}
+	expected := []NamedCount{NamedCount{"Active", 1}, NamedCount{"New", 0}, NamedCount{"VIP | LTV > 1000", 15}}
+	if len(counts) != len(expected) {
+		t.Fatalf("Counts were %v, expected %v", counts, expected)
+	}
+	for i := range expected {
+		if counts[i] != expected[i] {
+			t.Errorf("Count %d was %v, expected %v", i, counts[i], expected[i])
+		}
+	}
+}
+
+func TestCountAPIUserCountsByTag(t *testing.T) {
+	http := TestCountHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/user_tag_counts.json", expectedURI: "/counts"}
+	api := CountAPI{httpClient: &http}
+	counts, err := api.namedCounts(countParams{Type: "user", Count: "tag"})
+	if err != nil {
+		t.Fatalf("Error getting user counts by tag: %v", err)
+	}
+	if len(counts) != 2 || counts[1] != (NamedCount{"Beta Tester", 12}) {
+		t.Errorf("Counts were %v", counts)
+	}
+}
+
+func TestCountAPICompanyCountsBySegment(t *testing.T) {
+	http := TestCountHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/company_segment_counts.json", expectedURI: "/counts"}
+	api := CountAPI{httpClient: &http}
+	counts, err := api.namedCounts(countParams{Type: "company", Count: "segment"})
+	if err != nil {
+		t.Fatalf("Error getting company counts by segment: %v", err)
+	}
+	if len(counts) != 2 || counts[0] != (NamedCount{"Active", 4}) {
+		t.Errorf("Counts were %v", counts)
+	}
+}
+
+func TestCountAPICompanyUserCounts(t *testing.T) {
+	http := TestCountHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/company_user_counts.json", expectedURI: "/counts"}
+	api := CountAPI{httpClient: &http}
+	counts, err := api.namedCounts(countParams{Type: "company", Count: "user"})
+	if err != nil {
+		t.Fatalf("Error getting company user counts: %v", err)
+	}
+	if len(counts) != 2 || counts[0] != (NamedCount{"Important Company", 7}) || counts[1] != (NamedCount{"Small Company", 1}) {
+		t.Errorf("Counts were %v", counts)
+	}
+}
+
+func TestCountAPINamedCountsMissing(t *testing.T) {
+	http := TestCountHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/user_tag_counts.json", expectedURI: "/counts"}
+	api := CountAPI{httpClient: &http}
+	counts, err := api.namedCounts(countParams{Type: "user", Count: "segment"})
+	if err != nil || counts == nil || len(counts) != 0 {
+		t.Errorf("Expected no counts, got %v (%v)", counts, err)
+	}
+}
+
+type TestCountHTTPClient struct {
+	TestHTTPClient
+	t               *testing.T
+	fixtureFilename string
+	expectedURI     string
+	lastQueryParams interface{}
+}
+
+func (t *TestCountHTTPClient) Get(uri string, queryParams interface{}) ([]byte, error) {
+	if t.expectedURI != uri {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	t.lastQueryParams = queryParams
+	return ioutil.ReadFile(t.fixtureFilename)
+}
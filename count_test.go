@@ -0,0 +1,65 @@
+package intercom
+
+import "testing"
+
+func TestAppCounts(t *testing.T) {
+	counts, _ := (&CountService{Repository: TestCountAPI{t: t}}).AppCounts()
+	if counts.Users != 10 {
+		t.Errorf("Users was %d, expected 10", counts.Users)
+	}
+}
+
+func TestConversationCountsByAdmin(t *testing.T) {
+	counts, _ := (&CountService{Repository: TestCountAPI{t: t}}).ConversationCountsByAdmin()
+	if counts[0].ID != "1" || counts[0].Open != 3 {
+		t.Errorf("Admin counts were %v", counts)
+	}
+}
+
+func TestUserCounts(t *testing.T) {
+	countService := CountService{Repository: TestCountAPI{t: t}}
+	counts, _ := countService.UserCountsBySegment()
+	if counts[0].Name != "user/segment" {
+		t.Errorf("Expected user counts by segment, got %v", counts)
+	}
+	counts, _ = countService.UserCountsByTag()
+	if counts[0].Name != "user/tag" {
+		t.Errorf("Expected user counts by tag, got %v", counts)
+	}
+}
+
+func TestCompanyCounts(t *testing.T) {
+	countService := CountService{Repository: TestCountAPI{t: t}}
+	counts, _ := countService.CompanyCountsBySegment()
+	if counts[0].Name != "company/segment" {
+		t.Errorf("Expected company counts by segment, got %v", counts)
+	}
+	counts, _ = countService.CompanyCountsByTag()
+	if counts[0].Name != "company/tag" {
+		t.Errorf("Expected company counts by tag, got %v", counts)
+	}
+	counts, _ = countService.CompanyUserCounts()
+	if counts[0].Name != "company/user" {
+		t.Errorf("Expected company user counts, got %v", counts)
+	}
+}
+
+type TestCountAPI struct {
+	t *testing.T
+}
+
+func (t TestCountAPI) appCounts() (AppCounts, error) {
+	return AppCounts{Users: 10}, nil
+}
+
+func (t TestCountAPI) conversationCounts() (ConversationCounts, error) {
+	return ConversationCounts{Open: 3}, nil
+}
+
+func (t TestCountAPI) conversationCountsByAdmin() ([]AdminConversationCount, error) {
+	return []AdminConversationCount{AdminConversationCount{ID: "1", Open: 3}}, nil
+}
+
+func (t TestCountAPI) namedCounts(params countParams) ([]NamedCount, error) {
+	return []NamedCount{NamedCount{Name: params.Type + "/" + params.Count, Count: 1}}, nil
+}
@@ -0,0 +1,114 @@
+package intercom
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DataAttributeService handles interactions with the API through a DataAttributeRepository.
+type DataAttributeService struct {
+	Repository DataAttributeRepository
+}
+
+// DataAttribute represents an attribute which can be set on Contacts or Companies.
+type DataAttribute struct {
+	Type              string   `json:"type,omitempty"`
+	ID                int64    `json:"id,omitempty"`
+	Model             string   `json:"model,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	FullName          string   `json:"full_name,omitempty"`
+	Label             string   `json:"label,omitempty"`
+	Description       string   `json:"description,omitempty"`
+	DataType          string   `json:"data_type,omitempty"`
+	Options           []string `json:"options,omitempty"`
+	Custom            bool     `json:"custom"`
+	Archived          bool     `json:"archived"`
+	APIWritable       bool     `json:"api_writable"`
+	UIWritable        bool     `json:"ui_writable"`
+	MessengerWritable bool     `json:"messenger_writable"`
+	CreatedAt         int64    `json:"created_at,omitempty"`
+	UpdatedAt         int64    `json:"updated_at,omitempty"`
+}
+
+// DataAttributeList holds a list of DataAttributes
+type DataAttributeList struct {
+	DataAttributes []DataAttribute `json:"data"`
+}
+
+type dataAttributeListParams struct {
+	Model           string `url:"model,omitempty"`
+	IncludeArchived bool   `url:"include_archived,omitempty"`
+}
+
+// List the DataAttributes defined for a model, "contact" or "company".
+// An empty model lists the DataAttributes for both.
+func (d *DataAttributeService) List(model string, includeArchived bool) (DataAttributeList, error) {
+	if err := validateDataAttributeModel(model); err != nil {
+		return DataAttributeList{}, err
+	}
+	return d.Repository.list(dataAttributeListParams{Model: model, IncludeArchived: includeArchived})
+}
+
+// Create a custom DataAttribute, which needs a Name, Model and DataType.
+// List DataAttributes should have their Options set.
+func (d *DataAttributeService) Create(attribute DataAttribute) (DataAttribute, error) {
+	if attribute.Name == "" {
+		return DataAttribute{}, missing("Data Attribute Name")
+	}
+	if attribute.Model == "" || attribute.DataType == "" {
+		return DataAttribute{}, missing("Data Attribute Model or Data Type")
+	}
+	if err := validateDataAttributeModel(attribute.Model); err != nil {
+		return DataAttribute{}, err
+	}
+	return d.Repository.create(&attribute)
+}
+
+// Update the Description and Options of a custom DataAttribute, leaving those not set unchanged.
+// MessengerWritable is only changed if it's set, to true; SetMessengerWritable can also unset it.
+// Changes Intercom doesn't allow, such as to the DataType, are returned as an IntercomError.
+func (d *DataAttributeService) Update(id int64, attribute DataAttribute) (DataAttribute, error) {
+	return d.Repository.update(id, &attribute)
+}
+
+// SetMessengerWritable sets whether a custom DataAttribute can be set from the Messenger, changing nothing else.
+func (d *DataAttributeService) SetMessengerWritable(id int64, writable bool) (DataAttribute, error) {
+	return d.Repository.setMessengerWritable(id, writable)
+}
+
+// Archive a custom DataAttribute, so it can no longer be set.
+// Archived DataAttributes are only listed when includeArchived is set.
+func (d *DataAttributeService) Archive(id int64) (DataAttribute, error) {
+	return d.Repository.archive(id, true)
+}
+
+// Unarchive a previously archived custom DataAttribute.
+func (d *DataAttributeService) Unarchive(id int64) (DataAttribute, error) {
+	return d.Repository.archive(id, false)
+}
+
+// Find a DataAttribute in the DataAttributeList by its Name or FullName.
+// It is safe to call on a nil DataAttributeList.
+func (l *DataAttributeList) Find(name string) (DataAttribute, bool) {
+	if l == nil {
+		return DataAttribute{}, false
+	}
+	for _, attribute := range l.DataAttributes {
+		if attribute.Name == name || attribute.FullName == name {
+			return attribute, true
+		}
+	}
+	return DataAttribute{}, false
+}
+
+func validateDataAttributeModel(model string) error {
+	switch model {
+	case "", "contact", "company":
+		return nil
+	}
+	return errors.New("Invalid Data Attribute Model")
+}
+
+func (d DataAttribute) String() string {
+	return fmt.Sprintf("[intercom] data_attribute { id: %d, model: %s, full_name: %s, data_type: %s }", d.ID, d.Model, d.FullName, d.DataType)
+}
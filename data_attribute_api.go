@@ -0,0 +1,100 @@
+package intercom
+
+import (
+	"fmt"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// DataAttributeRepository defines the interface for working with DataAttributes through the API.
+type DataAttributeRepository interface {
+	list(params dataAttributeListParams) (DataAttributeList, error)
+	create(*DataAttribute) (DataAttribute, error)
+	update(id int64, attribute *DataAttribute) (DataAttribute, error)
+	archive(id int64, archived bool) (DataAttribute, error)
+	setMessengerWritable(id int64, writable bool) (DataAttribute, error)
+}
+
+// DataAttributeAPI implements DataAttributeRepository
+type DataAttributeAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+type requestDataAttribute struct {
+	Name              string                       `json:"name,omitempty"`
+	Model             string                       `json:"model,omitempty"`
+	DataType          string                       `json:"data_type,omitempty"`
+	Description       string                       `json:"description,omitempty"`
+	Options           []requestDataAttributeOption `json:"options,omitempty"`
+	MessengerWritable *bool                        `json:"messenger_writable,omitempty"`
+	Archived          *bool                        `json:"archived,omitempty"`
+}
+
+type requestDataAttributeOption struct {
+	Value string `json:"value"`
+}
+
+func (api DataAttributeAPI) list(params dataAttributeListParams) (DataAttributeList, error) {
+	dataAttributeList := DataAttributeList{}
+	data, err := api.httpClient.Get("/data_attributes", params)
+	if err != nil {
+		return dataAttributeList, err
+	}
+	err = unmarshal("/data_attributes", data, &dataAttributeList)
+	return dataAttributeList, err
+}
+
+func (api DataAttributeAPI) create(attribute *DataAttribute) (DataAttribute, error) {
+	requestAttribute := buildRequestDataAttribute(attribute)
+	requestAttribute.Name = attribute.Name
+	requestAttribute.Model = attribute.Model
+	requestAttribute.MessengerWritable = Bool(attribute.MessengerWritable)
+	data, err := api.httpClient.Post("/data_attributes", &requestAttribute)
+	if err != nil {
+		return DataAttribute{}, err
+	}
+	return api.unmarshal("/data_attributes", data)
+}
+
+func (api DataAttributeAPI) update(id int64, attribute *DataAttribute) (DataAttribute, error) {
+	requestAttribute := buildRequestDataAttribute(attribute)
+	return api.put(id, &requestAttribute)
+}
+
+func (api DataAttributeAPI) archive(id int64, archived bool) (DataAttribute, error) {
+	return api.put(id, &requestDataAttribute{Archived: Bool(archived)})
+}
+
+func (api DataAttributeAPI) setMessengerWritable(id int64, writable bool) (DataAttribute, error) {
+	return api.put(id, &requestDataAttribute{MessengerWritable: Bool(writable)})
+}
+
+func (api DataAttributeAPI) put(id int64, requestAttribute *requestDataAttribute) (DataAttribute, error) {
+	uri := fmt.Sprintf("/data_attributes/%d", id)
+	data, err := put(api.httpClient, uri, requestAttribute)
+	if err != nil {
+		return DataAttribute{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api DataAttributeAPI) unmarshal(uri string, data []byte) (DataAttribute, error) {
+	savedAttribute := DataAttribute{}
+	err := unmarshal(uri, data, &savedAttribute)
+	return savedAttribute, err
+}
+
+func buildRequestDataAttribute(attribute *DataAttribute) requestDataAttribute {
+	requestAttribute := requestDataAttribute{
+		DataType:    attribute.DataType,
+		Description: attribute.Description,
+	}
+	// false can't be told from unset, so isn't sent by update, which would unset the flag
+	if attribute.MessengerWritable {
+		requestAttribute.MessengerWritable = Bool(true)
+	}
+	for _, option := range attribute.Options {
+		requestAttribute.Options = append(requestAttribute.Options, requestDataAttributeOption{Value: option})
+	}
+	return requestAttribute
+}
@@ -0,0 +1,146 @@
+package intercom
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+func TestAPIListDataAttributes(t *testing.T) {
+	http := TestDataAttributeHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/data_attributes.json", expectedURI: "/data_attributes"}
+	api := DataAttributeAPI{httpClient: &http}
+	dataAttributeList, err := api.list(dataAttributeListParams{Model: "contact", IncludeArchived: true})
+	if err != nil {
+		t.Fatalf("Error listing data attributes: %v", err)
+	}
+	params := http.lastQueryParams.(dataAttributeListParams)
+	if params.Model != "contact" || !params.IncludeArchived {
+		t.Errorf("Query params were %v", params)
+	}
+	attribute := dataAttributeList.DataAttributes[1]
+	if attribute.ID != 34 || !attribute.Custom || attribute.FullName != "custom_attributes.plan_tier" {
+		t.Errorf("Data attribute was not parsed, got %v", attribute)
+	}
+	if len(attribute.Options) != 3 || attribute.Options[2] != "enterprise" {
+		t.Errorf("Options were %v", attribute.Options)
+	}
+	if archived := dataAttributeList.DataAttributes[2]; !archived.Archived {
+		t.Errorf("Data attribute %s should be archived", archived.Name)
+	}
+}
+
+func TestAPICreateDataAttribute(t *testing.T) {
+	http := TestDataAttributeHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/data_attribute.json", expectedURI: "/data_attributes"}
+	api := DataAttributeAPI{httpClient: &http}
+	attribute, err := api.create(&DataAttribute{Name: "plan_tier", Model: "contact", DataType: "string", Options: []string{"free", "pro"}})
+	if err != nil {
+		t.Fatalf("Error creating data attribute: %v", err)
+	}
+	if attribute.ID != 34 {
+		t.Errorf("Data attribute ID was %d, expected 34", attribute.ID)
+	}
+	b, _ := json.Marshal(http.lastRequest)
+	expected := `{"name":"plan_tier","model":"contact","data_type":"string","options":[{"value":"free"},{"value":"pro"}],"messenger_writable":false}`
+	if string(b) != expected {
+		t.Errorf("Request was %s, expected %s", b, expected)
+	}
+}
+
+func TestAPIUpdateDataAttribute(t *testing.T) {
+	http := TestDataAttributeHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/data_attribute.json", expectedURI: "/data_attributes/34"}
+	api := DataAttributeAPI{httpClient: &http}
+	api.update(34, &DataAttribute{Description: "The customer's plan"})
+	b, _ := json.Marshal(http.lastRequest)
+	expected := `{"description":"The customer's plan"}`
+	if string(b) != expected {
+		t.Errorf("Request was %s, expected %s", b, expected)
+	}
+
+	api.update(34, &DataAttribute{MessengerWritable: true})
+	b, _ = json.Marshal(http.lastRequest)
+	expected = `{"messenger_writable":true}`
+	if string(b) != expected {
+		t.Errorf("Request was %s, expected %s", b, expected)
+	}
+}
+
+func TestAPISetDataAttributeMessengerWritable(t *testing.T) {
+	http := TestDataAttributeHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/data_attribute.json", expectedURI: "/data_attributes/34"}
+	api := DataAttributeAPI{httpClient: &http}
+	api.setMessengerWritable(34, false)
+	b, _ := json.Marshal(http.lastRequest)
+	expected := `{"messenger_writable":false}`
+	if string(b) != expected {
+		t.Errorf("Request was %s, expected only %s", b, expected)
+	}
+}
+
+func TestAPIArchiveDataAttribute(t *testing.T) {
+	http := TestDataAttributeHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/data_attribute.json", expectedURI: "/data_attributes/34"}
+	api := DataAttributeAPI{httpClient: &http}
+	api.archive(34, true)
+	b, _ := json.Marshal(http.lastRequest)
+	if string(b) != `{"archived":true}` {
+		t.Errorf("Request was %s, expected only archived", b)
+	}
+	api.archive(34, false)
+	b, _ = json.Marshal(http.lastRequest)
+	if string(b) != `{"archived":false}` {
+		t.Errorf("Request was %s, expected only archived", b)
+	}
+}
+
+func TestAPIUpdateDataAttributeError(t *testing.T) {
+	http := TestDataAttributeHTTPClient{t: t, expectedURI: "/data_attributes/34", err: interfaces.HTTPError{StatusCode: 400, Code: "parameter_invalid", Message: "Data Type can't be changed"}}
+	api := DataAttributeAPI{httpClient: &http}
+	_, err := api.update(34, &DataAttribute{DataType: "integer"})
+	if intercomErr, ok := err.(IntercomError); !ok || intercomErr.GetCode() != "parameter_invalid" {
+		t.Errorf("Error was %v, expected a parameter_invalid IntercomError", err)
+	}
+}
+
+func TestAPIUpdateDataAttributeWithoutPut(t *testing.T) {
+	api := DataAttributeAPI{httpClient: TestHTTPClient{}}
+	if _, err := api.update(34, &DataAttribute{}); err == nil {
+		t.Errorf("Expected an error from a HTTP Client without PUT")
+	}
+}
+
+type TestDataAttributeHTTPClient struct {
+	TestHTTPClient
+	t               *testing.T
+	fixtureFilename string
+	expectedURI     string
+	lastQueryParams interface{}
+	lastRequest     interface{}
+	err             error
+}
+
+func (t *TestDataAttributeHTTPClient) Get(uri string, params interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	t.lastQueryParams = params
+	return ioutil.ReadFile(t.fixtureFilename)
+}
+
+func (t *TestDataAttributeHTTPClient) Post(uri string, body interface{}) ([]byte, error) {
+	return t.save(uri, body)
+}
+
+func (t *TestDataAttributeHTTPClient) Put(uri string, body interface{}) ([]byte, error) {
+	return t.save(uri, body)
+}
+
+func (t *TestDataAttributeHTTPClient) save(uri string, body interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	t.lastRequest = body
+	if t.err != nil {
+		return nil, t.err
+	}
+	return ioutil.ReadFile(t.fixtureFilename)
+}
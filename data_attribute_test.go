@@ -0,0 +1,92 @@
+package intercom
+
+import "testing"
+
+func TestListDataAttributes(t *testing.T) {
+	dataAttributeService := DataAttributeService{Repository: TestDataAttributeAPI{t: t}}
+	dataAttributeList, err := dataAttributeService.List("company", false)
+	if err != nil {
+		t.Fatalf("Error listing data attributes: %v", err)
+	}
+	if _, ok := dataAttributeList.Find("custom_attributes.plan_tier"); !ok {
+		t.Errorf("Data attribute was not found by full name")
+	}
+	if attribute, ok := dataAttributeList.Find("plan_tier"); !ok || attribute.Model != "company" {
+		t.Errorf("Data attribute was not found by name")
+	}
+	if _, ok := dataAttributeList.Find("plan_teir"); ok {
+		t.Errorf("Unknown data attribute should not be found")
+	}
+}
+
+func TestListDataAttributesInvalidModel(t *testing.T) {
+	dataAttributeService := DataAttributeService{Repository: TestDataAttributeAPI{t: t}}
+	if _, err := dataAttributeService.List("user", false); err == nil {
+		t.Errorf("Expected an error for an invalid model")
+	}
+}
+
+func TestCreateDataAttributeMissingName(t *testing.T) {
+	dataAttributeService := DataAttributeService{Repository: TestDataAttributeAPI{t: t}}
+	if _, err := dataAttributeService.Create(DataAttribute{Model: "contact", DataType: "string"}); err == nil {
+		t.Errorf("Expected an error for a missing name")
+	}
+}
+
+func TestCreateDataAttribute(t *testing.T) {
+	dataAttributeService := DataAttributeService{Repository: TestDataAttributeAPI{t: t}}
+	attribute, err := dataAttributeService.Create(DataAttribute{Name: "plan_tier", Model: "contact", DataType: "string"})
+	if err != nil {
+		t.Fatalf("Error creating data attribute: %v", err)
+	}
+	if attribute.ID != 34 {
+		t.Errorf("Data attribute ID was %d, expected 34", attribute.ID)
+	}
+}
+
+func TestArchiveDataAttribute(t *testing.T) {
+	dataAttributeService := DataAttributeService{Repository: TestDataAttributeAPI{t: t}}
+	if attribute, _ := dataAttributeService.Archive(34); !attribute.Archived {
+		t.Errorf("Data attribute was not archived")
+	}
+	if attribute, _ := dataAttributeService.Unarchive(34); attribute.Archived {
+		t.Errorf("Data attribute was not unarchived")
+	}
+}
+
+func TestSetDataAttributeMessengerWritable(t *testing.T) {
+	dataAttributeService := DataAttributeService{Repository: TestDataAttributeAPI{t: t}}
+	if attribute, _ := dataAttributeService.SetMessengerWritable(34, true); !attribute.MessengerWritable {
+		t.Errorf("Data attribute was not made messenger writable")
+	}
+}
+
+type TestDataAttributeAPI struct {
+	t *testing.T
+}
+
+func (t TestDataAttributeAPI) list(params dataAttributeListParams) (DataAttributeList, error) {
+	return DataAttributeList{DataAttributes: []DataAttribute{
+		DataAttribute{ID: 34, Model: params.Model, Name: "plan_tier", FullName: "custom_attributes.plan_tier", Custom: true},
+	}}, nil
+}
+
+func (t TestDataAttributeAPI) create(attribute *DataAttribute) (DataAttribute, error) {
+	created := *attribute
+	created.ID = 34
+	return created, nil
+}
+
+func (t TestDataAttributeAPI) update(id int64, attribute *DataAttribute) (DataAttribute, error) {
+	updated := *attribute
+	updated.ID = id
+	return updated, nil
+}
+
+func (t TestDataAttributeAPI) archive(id int64, archived bool) (DataAttribute, error) {
+	return DataAttribute{ID: id, Archived: archived}, nil
+}
+
+func (t TestDataAttributeAPI) setMessengerWritable(id int64, writable bool) (DataAttribute, error) {
+	return DataAttribute{ID: id, MessengerWritable: writable}, nil
+}
@@ -28,6 +28,9 @@ Errors may be returned from some calls. Errors returned from the API will implem
     fmt.Print(herr)
   }
 
+They also match intercom.ErrNotFound, intercom.ErrUnauthorized, intercom.ErrForbidden and intercom.ErrRateLimited with errors.Is,
+and an intercom.ValidationError can be got from requests rejected as invalid with errors.As.
+
 HTTP Client
 
 The HTTP Client used by this package can be swapped out for one of your choosing, with your own configuration, it just needs to implement the HTTPClient interface:
@@ -59,5 +62,21 @@ For many resources, pagination should be applied through the use of a PageParams
   }
   ic.Users.List(pageParams)
 
+Contacts and Conversations are paged with a cursor instead, from the Next page of the previous list.
+A StartingAfter cursor takes precedence over a Page number:
+
+  contactList, err := ic.Contacts.List(PageParams{PerPage: 50})
+  for err == nil && contactList.Pages.Next != nil {
+    contactList, err = ic.Contacts.List(PageParams{PerPage: 50, StartingAfter: contactList.Pages.Next.StartingAfter})
+  }
+
+Or, to follow every page of either kind, use a Pager:
+
+  contacts := ic.Contacts.All(PageParams{PerPage: 50})
+  for contacts.Next() {
+    contact := contacts.Item()
+  }
+  err := contacts.Err()
+
 */
 package intercom
@@ -0,0 +1,69 @@
+package intercom
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Environment variables read by NewClientFromEnv.
+const (
+	EnvAccessToken = "INTERCOM_ACCESS_TOKEN"
+	EnvAppID       = "INTERCOM_APP_ID"
+	EnvAPIKey      = "INTERCOM_API_KEY"
+	EnvRegion      = "INTERCOM_REGION"
+	EnvBaseURI     = "INTERCOM_BASE_URI"
+	EnvAPIVersion  = "INTERCOM_API_VERSION"
+	EnvTimeout     = "INTERCOM_TIMEOUT"
+)
+
+// NewClientFromEnv returns a new Intercom API client configured from the environment, and then any options:
+//
+//   - INTERCOM_ACCESS_TOKEN is sent as a Bearer token. Otherwise INTERCOM_APP_ID and INTERCOM_API_KEY are
+//     used for basic auth, as with NewClient. One or the other must be set.
+//   - INTERCOM_REGION is us, eu or au, see SetRegion, or INTERCOM_BASE_URI is the API host, see BaseURI.
+//   - INTERCOM_API_VERSION, see SetAPIVersion.
+//   - INTERCOM_TIMEOUT is a duration such as 30s, see RequestTimeout.
+//
+// Variables which are empty are treated as unset. An error is returned for missing credentials,
+// or any value which can't be used, rather than when requests are made.
+func NewClientFromEnv(opts ...option) (*Client, error) {
+	var envOpts []option
+	switch region := os.Getenv(EnvRegion); region {
+	case "":
+	case string(RegionUS), string(RegionEU), string(RegionAU):
+		envOpts = append(envOpts, SetRegion(Region(region)))
+	default:
+		return nil, fmt.Errorf("Invalid %s %q, expected us, eu or au", EnvRegion, region)
+	}
+	if baseURI := os.Getenv(EnvBaseURI); baseURI != "" {
+		if os.Getenv(EnvRegion) != "" {
+			return nil, fmt.Errorf("Both %s and %s Set", EnvRegion, EnvBaseURI)
+		}
+		envOpts = append(envOpts, BaseURI(baseURI))
+	}
+	if version := os.Getenv(EnvAPIVersion); version != "" {
+		envOpts = append(envOpts, SetAPIVersion(version))
+	}
+	if timeout := os.Getenv(EnvTimeout); timeout != "" {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil || duration < 0 {
+			return nil, fmt.Errorf("Invalid %s %q, expected a duration such as 30s", EnvTimeout, timeout)
+		}
+		envOpts = append(envOpts, RequestTimeout(duration))
+	}
+	opts = append(envOpts, opts...)
+
+	// An access token is preferred to the legacy App ID and API Key
+	if accessToken := os.Getenv(EnvAccessToken); accessToken != "" {
+		return NewOAuthClient(accessToken, opts...), nil
+	}
+	appID, apiKey := os.Getenv(EnvAppID), os.Getenv(EnvAPIKey)
+	if appID == "" && apiKey == "" {
+		return nil, fmt.Errorf("Missing %s", EnvAccessToken)
+	}
+	if appID == "" || apiKey == "" {
+		return nil, fmt.Errorf("Missing %s or %s", EnvAppID, EnvAPIKey)
+	}
+	return NewClient(appID, apiKey, opts...), nil
+}
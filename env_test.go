@@ -0,0 +1,70 @@
+package intercom
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+func setEnv(t *testing.T, env map[string]string) {
+	for _, name := range []string{EnvAccessToken, EnvAppID, EnvAPIKey, EnvRegion, EnvBaseURI, EnvAPIVersion, EnvTimeout} {
+		t.Setenv(name, env[name])
+	}
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	setEnv(t, map[string]string{EnvAccessToken: "token", EnvRegion: "eu", EnvAPIVersion: APIVersion2_11, EnvTimeout: "30s"})
+	ic, err := NewClientFromEnv(SetAPIVersion(APIVersion1_4))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if token := ic.HTTPClient.(interfaces.IntercomHTTPClient).AccessToken.Get(); token != "token" {
+		t.Errorf("Access token was %s", token)
+	}
+	if ic.baseURI != RegionEU.BaseURI() || ic.timeout != 30*time.Second {
+		t.Errorf("Base URI was %s and timeout %s", ic.baseURI, ic.timeout)
+	}
+	if ic.apiVersion != APIVersion1_4 {
+		t.Errorf("Expected options to override the environment, API version was %s", ic.apiVersion)
+	}
+}
+
+func TestNewClientFromEnvPrecedence(t *testing.T) {
+	setEnv(t, map[string]string{EnvAccessToken: "token", EnvAppID: "app_id", EnvAPIKey: "api_key"})
+	ic, err := NewClientFromEnv()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ic.AppID != "" || ic.HTTPClient.(interfaces.IntercomHTTPClient).AccessToken.Get() != "token" {
+		t.Errorf("Expected the access token to be used, got App ID %q", ic.AppID)
+	}
+
+	setEnv(t, map[string]string{EnvAppID: "app_id", EnvAPIKey: "api_key", EnvBaseURI: "http://intercom.dev"})
+	ic, err = NewClientFromEnv()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ic.AppID != "app_id" || ic.APIKey != "api_key" || ic.HTTPClient.(interfaces.IntercomHTTPClient).AccessToken.Get() != "" {
+		t.Errorf("Expected the App ID and API Key to be used, got %q and %q", ic.AppID, ic.APIKey)
+	}
+	if ic.baseURI != "http://intercom.dev" {
+		t.Errorf("Base URI was %s", ic.baseURI)
+	}
+}
+
+func TestNewClientFromEnvInvalid(t *testing.T) {
+	for name, env := range map[string]map[string]string{
+		"no credentials":   {EnvRegion: "eu"},
+		"no API key":       {EnvAppID: "app_id"},
+		"unknown region":   {EnvAccessToken: "token", EnvRegion: "mars"},
+		"region and base":  {EnvAccessToken: "token", EnvRegion: "eu", EnvBaseURI: "http://intercom.dev"},
+		"invalid timeout":  {EnvAccessToken: "token", EnvTimeout: "30"},
+		"negative timeout": {EnvAccessToken: "token", EnvTimeout: "-1s"},
+	} {
+		setEnv(t, env)
+		if ic, err := NewClientFromEnv(); err == nil || ic != nil {
+			t.Errorf("Expected an error with %s, got %v", name, err)
+		}
+	}
+}
@@ -1,15 +1,38 @@
 package intercom
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
 
 // EventService handles interactions with the API through an EventRepository.
 type EventService struct {
-	Repository EventRepository
+	Repository    EventRepository
+	JobRepository JobRepository
+
+	// SkipMetadataValidation turns off checking Event Metadata against Intercom's limits before sending.
+	SkipMetadataValidation bool
+
+	clock interfaces.Clock
 }
 
-// An Event represents a new event that happens to a User.
+// The maximum number of items Intercom accepts in each bulk Job request.
+const maxBulkJobItems = 100
+
+// How far into the future an Event's CreatedAt may be, allowing for clock skew.
+const maxEventClockSkew = 5 * time.Minute
+
+// How far into the past an Event's CreatedAt may be for Intercom to accept it.
+const maxEventAge = 90 * 24 * time.Hour
+
+// An Event represents a new event that happens to a User or Lead.
+// The User or Lead is identified by LeadID, ID, UserID or Email. When several are set,
+// LeadID takes precedence over ID, and Intercom matches on id, then user_id, then email.
 type Event struct {
 	ID        string                 `json:"id,omitempty"`
+	LeadID    string                 `json:"-"`
 	Email     string                 `json:"email,omitempty"`
 	UserID    string                 `json:"user_id,omitempty"`
 	EventName string                 `json:"event_name,omitempty"`
@@ -17,11 +40,193 @@ type Event struct {
 	Metadata  map[string]interface{} `json:"metadata,omitempty"`
 }
 
-// Save a new Event
+// EventList holds a list of Events and paging information
+type EventList struct {
+	Pages  PageParams `json:"pages"`
+	Events []Event    `json:"events"`
+}
+
+// EventSummaryList holds a summary of each Event a User has, by EventName.
+type EventSummaryList struct {
+	IntercomUserID string         `json:"intercom_user_id"`
+	UserID         string         `json:"user_id"`
+	Email          string         `json:"email"`
+	Events         []EventSummary `json:"events"`
+}
+
+// An EventSummary summarises the occurrences of a named Event for a User.
+type EventSummary struct {
+	Name        string    `json:"name"`
+	First       time.Time `json:"first"`
+	Last        time.Time `json:"last"`
+	Count       int64     `json:"count"`
+	Description string    `json:"description"`
+}
+
+// EventListParams are the query parameters for listing a User's Events, or their summaries.
+type EventListParams struct {
+	PageParams
+	Type           string `url:"type"`
+	Summary        bool   `url:"summary,omitempty"`
+	IntercomUserID string `url:"intercom_user_id,omitempty"`
+	UserID         string `url:"user_id,omitempty"`
+	Email          string `url:"email,omitempty"`
+	Before         int64  `url:"before,omitempty"`
+}
+
+// Save a new Event.
+// CreatedAt is set to now if it is zero, and must not be in the future, or more than 90 days ago.
 func (e *EventService) Save(event *Event) error {
-	return e.Repository.save(event)
+	if !event.hasIdentifier() {
+		return missing("Event Identifier")
+	}
+	if event.CreatedAt == 0 {
+		event.CreatedAt = e.now().Unix()
+	}
+	if err := validateEventCreatedAt(event.CreatedAt, e.now()); err != nil {
+		return err
+	}
+	if err := e.validateMetadata(event.Metadata); err != nil {
+		return err
+	}
+	return e.Repository.Save(event)
+}
+
+// List the Events for a User, most recent first.
+// The User is identified by their ID, UserID or Email, in that order of preference.
+// Pass the previous EventList's Pages as params to list the next, older, page.
+func (e *EventService) List(user *User, params PageParams) (EventList, error) {
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return EventList{}, err
+	}
+	listParams, err := newEventListParams(user, params)
+	if err != nil {
+		return EventList{}, err
+	}
+	return e.Repository.List(listParams)
+}
+
+// SaveBulk saves many Events through a bulk Job, returning the Job.
+// Events are sent in requests of at most 100, appending to the same Job.
+// Each Event must have an EventName, a CreatedAt within the last 90 days, and one of LeadID, ID, UserID or Email.
+// If a request fails, the Job already holding the Events sent before it is returned with the error.
+func (e *EventService) SaveBulk(events []Event) (JobResponse, error) {
+	job, _, err := e.saveBulk(events)
+	return job, err
+}
+
+// saveBulk is SaveBulk, also returning how many of the events were sent before any error.
+func (e *EventService) saveBulk(events []Event) (JobResponse, int, error) {
+	items := make([]*JobItem, len(events))
+	for i := range events {
+		if err := e.validateBulk(&events[i]); err != nil {
+			return JobResponse{}, 0, fmt.Errorf("event %d: %w", i, err)
+		}
+		items[i] = NewEventJobItem(&events[i])
+	}
+	job := JobResponse{}
+	for start := 0; start < len(items); start += maxBulkJobItems {
+		end := start + maxBulkJobItems
+		if end > len(items) {
+			end = len(items)
+		}
+		request := JobRequest{Items: items[start:end], bulkType: "events"}
+		if job.ID != "" {
+			request.JobData = &JobData{ID: job.ID}
+		}
+		saved, err := e.JobRepository.save(&request)
+		if err != nil {
+			return job, start, fmt.Errorf("events %d to %d: %w", start, end-1, err)
+		}
+		job = saved
+	}
+	return job, len(events), nil
+}
+
+func (e *EventService) validateBulk(event *Event) error {
+	if err := validateBulkEvent(event, e.now()); err != nil {
+		return err
+	}
+	return e.validateMetadata(event.Metadata)
+}
+
+func validateBulkEvent(event *Event, now time.Time) error {
+	switch {
+	case event.EventName == "":
+		return missing("EventName")
+	case event.CreatedAt == 0:
+		return missing("CreatedAt")
+	case !event.hasIdentifier():
+		return missing("Event Identifier")
+	}
+	return validateEventCreatedAt(event.CreatedAt, now)
+}
+
+func (e *EventService) validateMetadata(metadata map[string]interface{}) error {
+	if e.SkipMetadataValidation {
+		return nil
+	}
+	return validateEventMetadata(metadata)
+}
+
+// Summaries lists a summary of each Event for a User, by EventName.
+// A User without Events has an empty list of summaries.
+func (e *EventService) Summaries(user *User) (EventSummaryList, error) {
+	params, err := newEventListParams(user, PageParams{})
+	if err != nil {
+		return EventSummaryList{}, err
+	}
+	params.Summary = true
+	summaryList, err := e.Repository.Summaries(params)
+	if err == nil && summaryList.Events == nil {
+		summaryList.Events = []EventSummary{}
+	}
+	return summaryList, err
+}
+
+func newEventListParams(user *User, params PageParams) (EventListParams, error) {
+	listParams := EventListParams{PageParams: params, Type: "user"}
+	if params.Next != nil {
+		listParams.Before = params.Next.Before
+	}
+	switch {
+	case user == nil:
+		return listParams, missing("User")
+	case user.ID != "":
+		listParams.IntercomUserID = user.ID
+	case user.UserID != "":
+		listParams.UserID = user.UserID
+	case user.Email != "":
+		listParams.Email = user.Email
+	default:
+		return listParams, missing("User Identifier")
+	}
+	return listParams, nil
+}
+
+func (e *EventService) now() time.Time {
+	return clockOrReal(e.clock).Now()
+}
+
+func validateEventCreatedAt(createdAt int64, now time.Time) error {
+	switch at := time.Unix(createdAt, 0); {
+	case at.After(now.Add(maxEventClockSkew)):
+		return ArgumentError{Message: fmt.Sprintf("Event CreatedAt %d is in the future", createdAt)}
+	case at.Before(now.Add(-maxEventAge)):
+		return ArgumentError{Message: fmt.Sprintf("Event CreatedAt %d is more than 90 days ago", createdAt)}
+	}
+	return nil
+}
+
+func (e Event) hasIdentifier() bool {
+	return e.LeadID != "" || e.ID != "" || e.UserID != "" || e.Email != ""
 }
 
 func (e Event) String() string {
 	return fmt.Sprintf("[intercom] event { name: %s, user_id: %s, email: %s }", e.EventName, e.UserID, e.Email)
 }
+
+func (s EventSummary) String() string {
+	return fmt.Sprintf("[intercom] event_summary { name: %s, count: %d }", s.Name, s.Count)
+}
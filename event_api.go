@@ -1,10 +1,19 @@
 package intercom
 
-import "gopkg.in/intercom/intercom-go.v2/interfaces"
+import (
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
 
 // EventRepository defines the interface for working with Events through the API.
+// It can be implemented outside this package, such as to drop Events in load tests, and set on the EventService;
+// intercomtest.RunEventRepositoryTests checks an implementation behaves as EventAPI does.
 type EventRepository interface {
-	save(*Event) error
+	// Save records the Event, which the EventService has already validated.
+	Save(*Event) error
+	// List returns a page of the Events of the User identified by the params.
+	List(params EventListParams) (EventList, error)
+	// Summaries returns the counts of each Event the User identified by the params has, with Summary set.
+	Summaries(params EventListParams) (EventSummaryList, error)
 }
 
 // EventAPI implements EventRepository
@@ -12,7 +21,51 @@ type EventAPI struct {
 	httpClient interfaces.HTTPClient
 }
 
-func (api EventAPI) save(event *Event) error {
-	_, err := api.httpClient.Post("/events", event)
+type requestEvent struct {
+	ID        string                 `json:"id,omitempty"`
+	Email     string                 `json:"email,omitempty"`
+	UserID    string                 `json:"user_id,omitempty"`
+	EventName string                 `json:"event_name,omitempty"`
+	CreatedAt int64                  `json:"created_at,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (api EventAPI) Save(event *Event) error {
+	_, err := api.httpClient.Post("/events", buildRequestEvent(event))
 	return err
 }
+
+func buildRequestEvent(event *Event) requestEvent {
+	id := event.ID
+	if event.LeadID != "" {
+		id = event.LeadID
+	}
+	return requestEvent{
+		ID:        id,
+		Email:     event.Email,
+		UserID:    event.UserID,
+		EventName: event.EventName,
+		CreatedAt: event.CreatedAt,
+		Metadata:  event.Metadata,
+	}
+}
+
+func (api EventAPI) List(params EventListParams) (EventList, error) {
+	eventList := EventList{}
+	data, err := api.httpClient.Get("/events", params)
+	if err != nil {
+		return eventList, err
+	}
+	err = unmarshal("/events", data, &eventList)
+	return eventList, err
+}
+
+func (api EventAPI) Summaries(params EventListParams) (EventSummaryList, error) {
+	summaryList := EventSummaryList{}
+	data, err := api.httpClient.Get("/events", params)
+	if err != nil {
+		return summaryList, err
+	}
+	err = unmarshal("/events", data, &summaryList)
+	return summaryList, err
+}
@@ -1,9 +1,11 @@
 package intercom
 
 import (
+	"io/ioutil"
 	"testing"
 	"time"
 
+	"github.com/google/go-querystring/query"
 	"gopkg.in/intercom/intercom-go.v2/interfaces"
 )
 
@@ -11,30 +13,120 @@ func TestEventAPISave(t *testing.T) {
 	http := TestEventHTTPClient{t: t, expectedURI: "/events"}
 	api := EventAPI{httpClient: &http}
 	event := Event{UserID: "27", CreatedAt: int64(time.Now().Unix()), EventName: "govent"}
-	api.save(&event)
+	api.Save(&event)
+}
+
+func TestEventAPISaveLead(t *testing.T) {
+	http := TestEventHTTPClient{t: t, expectedURI: "/events"}
+	http.testFunc = func(body interface{}) {
+		if body.(requestEvent).ID != "5811e1c5" {
+			t.Errorf("Lead event expected to be sent with id 5811e1c5, was %v", body)
+		}
+	}
+	api := EventAPI{httpClient: &http}
+	event := Event{LeadID: "5811e1c5", ID: "54c42e7e", EventName: "govent"}
+	api.Save(&event)
 }
 
 func TestEventAPISaveFail(t *testing.T) {
 	http := TestEventHTTPClient{t: t, expectedURI: "/events", shouldFail: true}
 	api := EventAPI{httpClient: &http}
 	event := Event{UserID: "444", CreatedAt: int64(time.Now().Unix()), EventName: "govent"}
-	err := api.save(&event)
+	err := api.Save(&event)
 	if herr, ok := err.(interfaces.HTTPError); ok && herr.Code != "not_found" {
 		t.Errorf("Error not returned")
 	}
 }
 
+func TestEventAPIList(t *testing.T) {
+	http := TestEventHTTPClient{t: t, expectedURI: "/events", fixtureFilename: "intercomtest/fixtures/events.json"}
+	api := EventAPI{httpClient: &http}
+	eventList, err := api.List(EventListParams{Type: "user", IntercomUserID: "54c42e7ea7a765fa7"})
+	if err != nil {
+		t.Fatalf("Error listing events: %v", err)
+	}
+	if len(eventList.Events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(eventList.Events))
+	}
+	event := eventList.Events[0]
+	if event.EventName != "invited-friend" || event.CreatedAt != 1389913941 {
+		t.Errorf("Event was %v, expected invited-friend at 1389913941", event)
+	}
+	if event.Metadata["invitee_email"] != "pi@example.org" {
+		t.Errorf("Event metadata was %v", event.Metadata)
+	}
+}
+
+func TestEventListNextPage(t *testing.T) {
+	var listed []EventListParams
+	http := TestEventHTTPClient{t: t, expectedURI: "/events", fixtureFilename: "intercomtest/fixtures/events.json"}
+	http.testQuery = func(queryParams interface{}) {
+		listed = append(listed, queryParams.(EventListParams))
+	}
+	eventService := EventService{Repository: EventAPI{httpClient: &http}}
+	user := &User{ID: "54c42e7ea7a765fa7"}
+	eventList, err := eventService.List(user, PageParams{})
+	if err != nil {
+		t.Fatalf("Error listing events: %v", err)
+	}
+	if eventList.Pages.Next == nil || eventList.Pages.Next.Before != 1389913800 {
+		t.Fatalf("Next was %+v, expected before 1389913800", eventList.Pages.Next)
+	}
+	if _, err := eventService.List(user, eventList.Pages); err != nil {
+		t.Fatalf("Error listing events: %v", err)
+	}
+	if len(listed) != 2 || listed[0].Before != 0 || listed[1].Before != 1389913800 {
+		t.Errorf("Listed %+v, expected the second page before 1389913800", listed)
+	}
+	values, _ := query.Values(listed[1])
+	if values.Get("before") != "1389913800" || values.Get("intercom_user_id") != "54c42e7ea7a765fa7" {
+		t.Errorf("Query was %s, expected before=1389913800", values.Encode())
+	}
+}
+
+func TestEventAPISummaries(t *testing.T) {
+	http := TestEventHTTPClient{t: t, expectedURI: "/events", fixtureFilename: "intercomtest/fixtures/event_summaries.json"}
+	api := EventAPI{httpClient: &http}
+	summaryList, err := api.Summaries(EventListParams{Type: "user", UserID: "342311", Summary: true})
+	if err != nil {
+		t.Fatalf("Error listing event summaries: %v", err)
+	}
+	summary := summaryList.Events[0]
+	if summary.Name != "logged-in" || summary.Count != 34 {
+		t.Errorf("Summary was %v, expected logged-in with count 34", summary)
+	}
+	if summary.Last.Unix() != 1389913941 {
+		t.Errorf("Summary last was %v, expected 1389913941", summary.Last.Unix())
+	}
+}
+
 type TestEventHTTPClient struct {
 	TestHTTPClient
-	t           *testing.T
-	expectedURI string
-	shouldFail  bool
+	t               *testing.T
+	expectedURI     string
+	fixtureFilename string
+	shouldFail      bool
+	testFunc        func(body interface{})
+	testQuery       func(queryParams interface{})
+}
+
+func (t TestEventHTTPClient) Get(uri string, queryParams interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	if t.testQuery != nil {
+		t.testQuery(queryParams)
+	}
+	return ioutil.ReadFile(t.fixtureFilename)
 }
 
 func (t TestEventHTTPClient) Post(uri string, event interface{}) ([]byte, error) {
 	if uri != "/events" {
 		t.t.Errorf("Wrong endpoint called")
 	}
+	if t.testFunc != nil {
+		t.testFunc(event)
+	}
 	if t.shouldFail {
 		err := interfaces.HTTPError{StatusCode: 404, Code: "not_found", Message: "User Not Found"}
 		return nil, err
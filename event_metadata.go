@@ -0,0 +1,76 @@
+package intercom
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Limits Intercom places on the Metadata of an Event. Structured values are
+// monetary amounts, rich links and Stripe identifiers.
+const (
+	maxMetadataKeys       = 10
+	maxMetadataKeyLength  = 255
+	maxStructuredMetadata = 5
+)
+
+// Metadata keys which Intercom treats as Stripe identifiers, linking the Event to Stripe.
+const (
+	StripeInvoiceKey  = "stripe_invoice"
+	StripeCustomerKey = "stripe_customer"
+)
+
+// EventMonetaryAmount is an amount of money in Event Metadata, in the smallest unit of its currency.
+type EventMonetaryAmount struct {
+	Amount   int    `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// EventRichLink is a link in Event Metadata, displayed as Value.
+type EventRichLink struct {
+	URL   string `json:"url"`
+	Value string `json:"value"`
+}
+
+// MonetaryAmount creates an EventMonetaryAmount, in cents, for use in Event Metadata.
+// The currency is an ISO 4217 code, such as "usd" or "gbp".
+func MonetaryAmount(cents int, currency string) EventMonetaryAmount {
+	return EventMonetaryAmount{Amount: cents, Currency: currency}
+}
+
+// RichLink creates an EventRichLink for use in Event Metadata.
+func RichLink(url, value string) EventRichLink {
+	return EventRichLink{URL: url, Value: value}
+}
+
+func validateEventMetadata(metadata map[string]interface{}) error {
+	if len(metadata) > maxMetadataKeys {
+		return ArgumentError{Message: fmt.Sprintf("Event Metadata has %d keys, the maximum is %d", len(metadata), maxMetadataKeys)}
+	}
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	structured := 0
+	for _, key := range keys {
+		if key == "" || len(key) > maxMetadataKeyLength {
+			return ArgumentError{Message: fmt.Sprintf("Event Metadata key %q must be between 1 and %d characters", key, maxMetadataKeyLength)}
+		}
+		switch metadata[key].(type) {
+		case EventMonetaryAmount, *EventMonetaryAmount, EventRichLink, *EventRichLink:
+			structured++
+		case string:
+			if key == StripeInvoiceKey || key == StripeCustomerKey {
+				structured++
+			}
+		case nil, bool, json.Number, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		default:
+			return ArgumentError{Message: fmt.Sprintf("Event Metadata key %q has unsupported value type %T", key, metadata[key])}
+		}
+	}
+	if structured > maxStructuredMetadata {
+		return ArgumentError{Message: fmt.Sprintf("Event Metadata has %d structured values, the maximum is %d", structured, maxStructuredMetadata)}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package intercom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEventMetadataSerialisation(t *testing.T) {
+	event := Event{EventName: "ordered", Metadata: map[string]interface{}{
+		"price":          MonetaryAmount(349, "eur"),
+		"order":          RichLink("https://example.io/orders/123", "Order 123"),
+		StripeInvoiceKey: "inv_3434343434",
+	}}
+	b, _ := json.Marshal(event)
+	expected := `{"event_name":"ordered","metadata":{"order":{"url":"https://example.io/orders/123","value":"Order 123"},"price":{"amount":349,"currency":"eur"},"stripe_invoice":"inv_3434343434"}}`
+	if string(b) != expected {
+		t.Errorf("Event serialised as %s, expected %s", b, expected)
+	}
+}
+
+func TestEventMetadataStructuredLimit(t *testing.T) {
+	metadata := map[string]interface{}{
+		"a":               MonetaryAmount(1, "usd"),
+		"b":               RichLink("https://example.io/b", "b"),
+		"c":               &EventRichLink{URL: "https://example.io/c", Value: "c"},
+		StripeCustomerKey: "cus_42",
+		"e":               MonetaryAmount(2, "usd"),
+		"plain":           "not structured",
+	}
+	if err := validateEventMetadata(metadata); err != nil {
+		t.Errorf("Expected 5 structured values to be valid, got %v", err)
+	}
+	metadata["f"] = RichLink("https://example.io/f", "f")
+	if err := validateEventMetadata(metadata); err == nil {
+		t.Errorf("Expected 6 structured values to be invalid")
+	}
+}
+
+func TestEventMetadataKeyLimit(t *testing.T) {
+	metadata := map[string]interface{}{}
+	for i := 0; i < 11; i++ {
+		metadata[string(rune('a'+i))] = i
+	}
+	if err := validateEventMetadata(metadata); err == nil {
+		t.Errorf("Expected 11 keys to be invalid")
+	}
+	delete(metadata, "a")
+	if err := validateEventMetadata(metadata); err != nil {
+		t.Errorf("Expected 10 keys to be valid, got %v", err)
+	}
+}
+
+func TestEventMetadataInvalidValues(t *testing.T) {
+	invalid := map[string]interface{}{
+		"nested":                  map[string]interface{}{"a": 1},
+		"list":                    []string{"a"},
+		"":                        "empty key",
+		string(make([]byte, 256)): "long key",
+	}
+	for key, value := range invalid {
+		err := validateEventMetadata(map[string]interface{}{key: value})
+		if err == nil {
+			t.Errorf("Expected metadata %q to be invalid", key)
+		} else if key == "nested" && err.Error() != `Event Metadata key "nested" has unsupported value type map[string]interface {}` {
+			t.Errorf("Expected error to name the offending key, got %v", err)
+		}
+	}
+}
+
+func TestEventSaveSkipMetadataValidation(t *testing.T) {
+	eventService := EventService{Repository: TestEventAPI{t: t, body: func(t *testing.T, event Event) error { return nil }}}
+	event := Event{UserID: "27", EventName: "govent", Metadata: map[string]interface{}{"nested": map[string]interface{}{}}}
+	if err := eventService.Save(&event); err == nil {
+		t.Errorf("Expected nested metadata to be rejected")
+	}
+	eventService.SkipMetadataValidation = true
+	if err := eventService.Save(&event); err != nil {
+		t.Errorf("Expected validation to be skipped, got %v", err)
+	}
+}
@@ -0,0 +1,245 @@
+package intercom
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrEventBufferFull is returned by AsyncSender.Send when the buffer is full and the Event was dropped.
+var ErrEventBufferFull = errors.New("Event Buffer Full")
+
+// ErrAsyncSenderClosed is returned when using an AsyncSender after it has been closed.
+var ErrAsyncSenderClosed = errors.New("Async Sender Closed")
+
+const (
+	asyncSenderBackoff    = time.Second
+	maxAsyncSenderBackoff = time.Minute
+)
+
+// AsyncSenderOptions configures an AsyncSender. Zero values take the defaults given.
+type AsyncSenderOptions struct {
+	// BufferSize is the number of Events which may be queued. Defaults to 1000.
+	BufferSize int
+	// BatchSize is the number of queued Events which triggers a flush. Defaults to 100.
+	BatchSize int
+	// FlushInterval is how often queued Events are flushed. Defaults to 5 seconds.
+	FlushInterval time.Duration
+	// BlockWhenFull makes Send wait for room in the buffer, rather than dropping the Event.
+	BlockWhenFull bool
+	// MaxRetries is how many times a bulk request is retried, backing off from a second, when it's
+	// rate limited, fails with a server error, or gets no response. Defaults to 3; set it negative not to retry.
+	MaxRetries int
+	// OnError is called with each Event which could not be saved.
+	OnError func(event *Event, err error)
+}
+
+// An AsyncSender queues Events in memory, and saves them from a background goroutine,
+// each batch in a bulk Job, as EventService.SaveBulk does. Failed requests are retried up to MaxRetries
+// times, on top of any retries the Client makes. It must be closed to stop the goroutine and save any queued Events.
+type AsyncSender struct {
+	service *EventService
+	opts    AsyncSenderOptions
+	events  chan *Event
+	flushes chan chan struct{}
+	closing chan struct{}
+	done    chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+	sends  sync.WaitGroup
+}
+
+// NewAsyncSender creates an AsyncSender which saves Events through the EventService.
+func (e *EventService) NewAsyncSender(opts AsyncSenderOptions) *AsyncSender {
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = 1000
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = 5 * time.Second
+	}
+	if opts.MaxRetries == 0 {
+		opts.MaxRetries = 3
+	}
+	s := &AsyncSender{
+		service: e,
+		opts:    opts,
+		events:  make(chan *Event, opts.BufferSize),
+		flushes: make(chan chan struct{}),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Send queues a copy of an Event to be saved. CreatedAt is set to now if it is zero.
+// If the buffer is full the Event is dropped and ErrEventBufferFull returned,
+// unless BlockWhenFull is set.
+func (s *AsyncSender) Send(event *Event) error {
+	queued := *event
+	if queued.CreatedAt == 0 {
+		queued.CreatedAt = s.service.now().Unix()
+	}
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return ErrAsyncSenderClosed
+	}
+	// Close waits for sends in progress before closing the channel, so it's safe to send without the lock.
+	s.sends.Add(1)
+	s.mu.RUnlock()
+	defer s.sends.Done()
+	if s.opts.BlockWhenFull {
+		select {
+		case s.events <- &queued:
+			return nil
+		case <-s.closing:
+			return ErrAsyncSenderClosed
+		}
+	}
+	select {
+	case s.events <- &queued:
+		return nil
+	default:
+		return ErrEventBufferFull
+	}
+}
+
+// Flush saves all queued Events, waiting until they are saved or the context is done.
+func (s *AsyncSender) Flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+	select {
+	case s.flushes <- flushed:
+	case <-s.done:
+		return ErrAsyncSenderClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting Events, and waits for any queued Events to be saved.
+func (s *AsyncSender) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return ErrAsyncSenderClosed
+	}
+	s.closed = true
+	s.mu.Unlock()
+	close(s.closing)
+	s.sends.Wait()
+	close(s.events)
+	<-s.done
+	return nil
+}
+
+func (s *AsyncSender) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.opts.FlushInterval)
+	defer ticker.Stop()
+	batch := make([]*Event, 0, s.opts.BatchSize)
+	for {
+		select {
+		case event, ok := <-s.events:
+			if !ok {
+				s.save(batch)
+				return
+			}
+			batch = append(batch, event)
+			if len(batch) >= s.opts.BatchSize {
+				s.save(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			s.save(batch)
+			batch = batch[:0]
+		case flushed := <-s.flushes:
+			batch = s.drain(batch)
+			s.save(batch)
+			batch = batch[:0]
+			close(flushed)
+		}
+	}
+}
+
+func (s *AsyncSender) drain(batch []*Event) []*Event {
+	for {
+		select {
+		case event, ok := <-s.events:
+			if !ok {
+				return batch
+			}
+			batch = append(batch, event)
+		default:
+			return batch
+		}
+	}
+}
+
+// save saves the batch through a bulk Job, retrying failed requests,
+// and reporting invalid Events, and those which could not be sent.
+func (s *AsyncSender) save(batch []*Event) {
+	events := make([]Event, 0, len(batch))
+	for _, event := range batch {
+		if err := s.service.validateBulk(event); err != nil {
+			s.reportError(event, err)
+			continue
+		}
+		events = append(events, *event)
+	}
+	if len(events) == 0 {
+		return
+	}
+	backoff := asyncSenderBackoff
+	for retries := 0; ; retries++ {
+		_, sent, err := s.service.saveBulk(events)
+		events = events[sent:]
+		if err == nil {
+			return
+		}
+		if retries >= s.opts.MaxRetries || !retryableBulkError(err) {
+			for i := range events {
+				s.reportError(&events[i], err)
+			}
+			return
+		}
+		wait, rateLimited := rateLimitBackoff(err, s.service.now(), backoff/2, maxAsyncSenderBackoff)
+		if !rateLimited {
+			wait = backoff
+		}
+		sleep(s.service.clock, wait)
+		if backoff *= 2; backoff > maxAsyncSenderBackoff {
+			backoff = maxAsyncSenderBackoff
+		}
+	}
+}
+
+// retryableBulkError reports whether a failed bulk request is worth sending again:
+// if it was rate limited, failed with a server error, or got no response at all.
+func retryableBulkError(err error) bool {
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+	var intercomError IntercomError
+	if errors.As(err, &intercomError) {
+		return intercomError.GetStatusCode() >= 500
+	}
+	return true
+}
+
+func (s *AsyncSender) reportError(event *Event, err error) {
+	if s.opts.OnError != nil {
+		s.opts.OnError(event, err)
+	}
+}
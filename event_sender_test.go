@@ -0,0 +1,190 @@
+package intercom
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+func TestAsyncSenderFlush(t *testing.T) {
+	repo := &TestAsyncJobAPI{}
+	sender := (&EventService{JobRepository: repo}).NewAsyncSender(AsyncSenderOptions{FlushInterval: time.Hour})
+	defer sender.Close()
+	for i := 0; i < 3; i++ {
+		if err := sender.Send(&Event{UserID: "27", EventName: "govent"}); err != nil {
+			t.Fatalf("Error sending event: %v", err)
+		}
+	}
+	if err := sender.Flush(context.Background()); err != nil {
+		t.Fatalf("Error flushing: %v", err)
+	}
+	if repo.count() != 3 {
+		t.Errorf("Expected 3 events to be saved, got %d", repo.count())
+	}
+	if repo.saved[0].CreatedAt == 0 {
+		t.Errorf("Expected CreatedAt to be set when sent")
+	}
+}
+
+func TestAsyncSenderBatchSize(t *testing.T) {
+	repo := &TestAsyncJobAPI{}
+	sender := (&EventService{JobRepository: repo}).NewAsyncSender(AsyncSenderOptions{BatchSize: 2, FlushInterval: time.Hour})
+	defer sender.Close()
+	sender.Send(&Event{UserID: "27", EventName: "govent"})
+	sender.Send(&Event{UserID: "27", EventName: "govent"})
+	for i := 0; i < 100 && repo.count() < 2; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	if repo.count() != 2 {
+		t.Errorf("Expected a full batch to be saved without flushing, got %d", repo.count())
+	}
+}
+
+func TestAsyncSenderClose(t *testing.T) {
+	repo := &TestAsyncJobAPI{}
+	sender := (&EventService{JobRepository: repo}).NewAsyncSender(AsyncSenderOptions{FlushInterval: time.Hour})
+	sender.Send(&Event{UserID: "27", EventName: "govent"})
+	sender.Close()
+	if repo.count() != 1 {
+		t.Errorf("Expected queued events to be saved on close, got %d", repo.count())
+	}
+	if err := sender.Send(&Event{UserID: "27", EventName: "govent"}); err != ErrAsyncSenderClosed {
+		t.Errorf("Expected ErrAsyncSenderClosed, got %v", err)
+	}
+}
+
+func TestAsyncSenderDropsWhenFull(t *testing.T) {
+	repo := &TestAsyncJobAPI{block: make(chan struct{})}
+	sender := (&EventService{JobRepository: repo}).NewAsyncSender(AsyncSenderOptions{BufferSize: 1, BatchSize: 1, FlushInterval: time.Hour})
+	sender.Send(&Event{UserID: "27", EventName: "govent"})
+	var err error
+	for i := 0; i < 3 && err == nil; i++ {
+		err = sender.Send(&Event{UserID: "27", EventName: "govent"})
+	}
+	if err != ErrEventBufferFull {
+		t.Errorf("Expected ErrEventBufferFull, got %v", err)
+	}
+	close(repo.block)
+	sender.Close()
+}
+
+func TestAsyncSenderBulkJob(t *testing.T) {
+	repo := &TestAsyncJobAPI{}
+	sender := (&EventService{JobRepository: repo}).NewAsyncSender(AsyncSenderOptions{BatchSize: 250, FlushInterval: time.Hour})
+	for i := 0; i < 150; i++ {
+		sender.Send(&Event{UserID: "27", EventName: "govent"})
+	}
+	sender.Close()
+	if repo.count() != 150 || repo.requests != 2 {
+		t.Errorf("Expected 150 events saved in 2 bulk requests, got %d in %d", repo.count(), repo.requests)
+	}
+}
+
+func TestAsyncSenderReportsErrors(t *testing.T) {
+	repo := &TestAsyncJobAPI{errs: []error{interfaces.HTTPError{StatusCode: 503}}}
+	var failed []*Event
+	sender := (&EventService{JobRepository: repo}).NewAsyncSender(AsyncSenderOptions{
+		FlushInterval: time.Hour,
+		MaxRetries:    -1,
+		OnError:       func(event *Event, err error) { failed = append(failed, event) },
+	})
+	sender.Send(&Event{UserID: "27", EventName: "govent"})
+	sender.Send(&Event{UserID: "27"})
+	sender.Flush(context.Background())
+	if repo.requests != 1 || repo.count() != 0 {
+		t.Errorf("Expected a single failed request, got %d saving %d events", repo.requests, repo.count())
+	}
+	if len(failed) != 2 || failed[0].EventName != "" || failed[1].EventName != "govent" {
+		t.Errorf("Expected the invalid event, then the event in the failed request, to be reported, got %v", failed)
+	}
+
+	failed = nil
+	sender.Send(&Event{UserID: "27", EventName: "govent"})
+	sender.Close()
+	if len(failed) != 0 || repo.count() != 1 {
+		t.Errorf("Expected the next batch to be saved, got %d saved and %v failed", repo.count(), failed)
+	}
+}
+
+func TestAsyncSenderRetries(t *testing.T) {
+	rateLimited := RateLimitError{HTTPError: interfaces.HTTPError{StatusCode: 429}, RetryAfter: "10"}
+	repo := &TestAsyncJobAPI{errs: []error{interfaces.HTTPError{StatusCode: 503}, rateLimited}}
+	clock := &testClock{now: time.Unix(1500000000, 0)}
+	var failed []*Event
+	sender := (&EventService{JobRepository: repo, clock: clock}).NewAsyncSender(AsyncSenderOptions{
+		FlushInterval: time.Hour,
+		OnError:       func(event *Event, err error) { failed = append(failed, event) },
+	})
+	sender.Send(&Event{UserID: "27", EventName: "govent"})
+	sender.Flush(context.Background())
+	if repo.requests != 3 || repo.count() != 1 || len(failed) != 0 {
+		t.Errorf("Expected the event to be saved by the third request, got %d saved in %d, %d failed", repo.count(), repo.requests, len(failed))
+	}
+	if len(clock.waits) != 2 || clock.waits[0] != asyncSenderBackoff || clock.waits[1] != 10*time.Second {
+		t.Errorf("Expected to back off, then wait as long as Retry-After said, got %v", clock.waits)
+	}
+
+	repo.errs = []error{interfaces.HTTPError{StatusCode: 422}}
+	sender.Send(&Event{UserID: "27", EventName: "govent"})
+	sender.Close()
+	if repo.requests != 4 || len(failed) != 1 {
+		t.Errorf("Expected a rejected request not to be retried, got %d requests, %d failed", repo.requests, len(failed))
+	}
+}
+
+func TestAsyncSenderCloseWhileBlocked(t *testing.T) {
+	repo := &TestAsyncJobAPI{block: make(chan struct{})}
+	sender := (&EventService{JobRepository: repo}).NewAsyncSender(AsyncSenderOptions{BufferSize: 1, BatchSize: 1, FlushInterval: time.Hour, BlockWhenFull: true})
+	sent := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		go func() { sent <- sender.Send(&Event{UserID: "27", EventName: "govent"}) }()
+	}
+	<-sent
+	<-sent
+	go sender.Close()
+	select {
+	case err := <-sent:
+		if err != ErrAsyncSenderClosed {
+			t.Errorf("Expected the blocked Send to return ErrAsyncSenderClosed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected Close to unblock Send")
+	}
+	close(repo.block)
+}
+
+type TestAsyncJobAPI struct {
+	TestJobRepository
+	mu       sync.Mutex
+	requests int
+	errs     []error
+	saved    []requestEvent
+	block    chan struct{}
+}
+
+func (t *TestAsyncJobAPI) save(job *JobRequest) (JobResponse, error) {
+	if t.block != nil {
+		<-t.block
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.requests++
+	if len(t.errs) > 0 {
+		err := t.errs[0]
+		t.errs = t.errs[1:]
+		return JobResponse{}, err
+	}
+	for _, item := range job.Items {
+		t.saved = append(t.saved, item.Data.(requestEvent))
+	}
+	return JobResponse{ID: "job_5ca1ab1eca11ab1e"}, nil
+}
+
+func (t *TestAsyncJobAPI) count() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.saved)
+}
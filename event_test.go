@@ -1,14 +1,18 @@
 package intercom
 
 import (
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
 )
 
 func TestEventSaveFail(t *testing.T) {
 	eventService := EventService{Repository: TestEventAPI{t: t, body: failBody}}
-	err := eventService.Save(&Event{})
+	err := eventService.Save(&Event{UserID: "444"})
 	if err.Error() != "Missing Identifier" {
 		t.Errorf("Error not propagated")
 	}
@@ -28,6 +32,139 @@ func TestEventSave(t *testing.T) {
 	eventService.Save(&event)
 }
 
+func TestEventSaveMissingIdentifier(t *testing.T) {
+	eventService := EventService{Repository: TestEventAPI{t: t, body: successBody}}
+	if err := eventService.Save(&Event{EventName: "govent"}); err == nil {
+		t.Errorf("Expected an Event without identifiers to be rejected")
+	}
+}
+
+func TestEventSaveDefaultsCreatedAt(t *testing.T) {
+	eventService := EventService{Repository: TestEventAPI{t: t, body: func(t *testing.T, event Event) error {
+		if event.CreatedAt != 1389913941 {
+			t.Errorf("CreatedAt was %d, expected 1389913941", event.CreatedAt)
+		}
+		return nil
+	}}, clock: &testClock{now: time.Unix(1389913941, 0)}}
+	eventService.Save(&Event{UserID: "27", EventName: "govent"})
+}
+
+func TestEventSaveFutureCreatedAt(t *testing.T) {
+	eventService := EventService{Repository: TestEventAPI{t: t, body: successBody}}
+	event := Event{UserID: "27", EventName: "govent", Metadata: map[string]interface{}{"is_cool": true}, CreatedAt: time.Now().Add(time.Hour).Unix()}
+	if err := eventService.Save(&event); err == nil {
+		t.Errorf("Expected an Event in the future to be rejected")
+	}
+	event.CreatedAt = time.Now().Add(time.Minute).Unix()
+	if err := eventService.Save(&event); err != nil {
+		t.Errorf("Expected an Event within the clock skew to be accepted, got %v", err)
+	}
+}
+
+func TestEventSaveOldCreatedAt(t *testing.T) {
+	now := time.Unix(1389913941, 0)
+	eventService := EventService{Repository: TestEventAPI{t: t, body: successBody}, JobRepository: &TestJobRepository{t: t}, clock: &testClock{now: now}}
+	event := Event{UserID: "27", EventName: "govent", Metadata: map[string]interface{}{"is_cool": true}, CreatedAt: now.Add(-maxEventAge - time.Hour).Unix()}
+	if err := eventService.Save(&event); err == nil {
+		t.Errorf("Expected an Event older than %s to be rejected", maxEventAge)
+	}
+	if _, err := eventService.SaveBulk([]Event{event}); err == nil {
+		t.Errorf("Expected a bulk Event older than %s to be rejected", maxEventAge)
+	}
+	event.CreatedAt = now.Add(-maxEventAge + time.Hour).Unix()
+	if err := eventService.Save(&event); err != nil {
+		t.Errorf("Expected an Event within %s to be accepted, got %v", maxEventAge, err)
+	}
+	if _, err := eventService.SaveBulk([]Event{event}); err != nil {
+		t.Errorf("Expected a bulk Event within %s to be accepted, got %v", maxEventAge, err)
+	}
+}
+
+func TestEventSaveTooManyStructuredValues(t *testing.T) {
+	eventService := EventService{Repository: TestEventAPI{t: t, body: successBody}}
+	event := Event{UserID: "27", EventName: "govent", Metadata: map[string]interface{}{}}
+	for _, key := range []string{"a", "b", "c", "d", "e", "f"} {
+		event.Metadata[key] = MonetaryAmount(100, "usd")
+	}
+	if err := eventService.Save(&event); err == nil {
+		t.Errorf("Expected too many structured values to be rejected")
+	}
+}
+
+func TestEventSaveBulk(t *testing.T) {
+	repo := &TestJobRepository{t: t}
+	var requests []*JobRequest
+	repo.f = func(job *JobRequest) { requests = append(requests, job) }
+	eventService := EventService{JobRepository: repo, clock: &testClock{now: time.Unix(1389913941, 0)}}
+	events := make([]Event, 250)
+	for i := range events {
+		events[i] = Event{UserID: "27", EventName: "govent", CreatedAt: 1389913941}
+	}
+	if _, err := eventService.SaveBulk(events); err != nil {
+		t.Fatalf("Error saving bulk events: %v", err)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("Expected 3 bulk requests, got %d", len(requests))
+	}
+	if len(requests[0].Items) != 100 || len(requests[2].Items) != 50 {
+		t.Errorf("Expected requests of 100, 100 and 50 items, got %d and %d", len(requests[0].Items), len(requests[2].Items))
+	}
+	if requests[0].JobData != nil || requests[1].JobData == nil {
+		t.Errorf("Expected the first request to create a job, and later requests to append to it")
+	}
+	if requests[1].bulkType != "events" || requests[1].Items[0].DataType != "event" {
+		t.Errorf("Expected event job items")
+	}
+}
+
+func TestEventSaveBulkLead(t *testing.T) {
+	repo := &TestJobRepository{t: t}
+	var body []byte
+	repo.f = func(job *JobRequest) { body, _ = json.Marshal(job.Items[0]) }
+	eventService := EventService{JobRepository: repo, clock: &testClock{now: time.Unix(1389913941, 0)}}
+	event := Event{LeadID: "lead-27", EventName: "govent", CreatedAt: 1389913941}
+	if _, err := eventService.SaveBulk([]Event{event}); err != nil {
+		t.Fatalf("Error saving bulk lead event: %v", err)
+	}
+	if !strings.Contains(string(body), `"id":"lead-27"`) {
+		t.Errorf("Expected the lead's id in the job item, got %s", body)
+	}
+}
+
+func TestEventSaveBulkFailedRequest(t *testing.T) {
+	repo := &TestJobRepository{t: t, saveErrs: []error{nil, interfaces.HTTPError{StatusCode: 500, Code: "server_error"}}}
+	eventService := EventService{JobRepository: repo, clock: &testClock{now: time.Unix(1389913941, 0)}}
+	events := make([]Event, 250)
+	for i := range events {
+		events[i] = Event{UserID: "27", EventName: "govent", CreatedAt: 1389913941}
+	}
+	job, err := eventService.SaveBulk(events)
+	if job.ID != "job_5ca1ab1eca11ab1e" {
+		t.Errorf("Job was %+v, expected the Job created by the first request", job)
+	}
+	if err == nil || !strings.Contains(err.Error(), "events 100 to 199") || !errors.As(err, &interfaces.HTTPError{}) {
+		t.Errorf("Error was %v, expected the failed request's HTTPError for events 100 to 199", err)
+	}
+}
+
+func TestEventSaveBulkInvalid(t *testing.T) {
+	repo := &TestJobRepository{t: t}
+	repo.f = func(job *JobRequest) { t.Errorf("Expected no request for invalid events") }
+	eventService := EventService{JobRepository: repo, clock: &testClock{now: time.Unix(1389913941, 0)}}
+	invalid := []Event{
+		Event{UserID: "27", CreatedAt: 1389913941},
+		Event{UserID: "27", EventName: "govent"},
+		Event{EventName: "govent", CreatedAt: 1389913941},
+		Event{UserID: "27", EventName: "govent", CreatedAt: 1389913941 + 3600},
+		Event{UserID: "27", EventName: "govent", CreatedAt: 1389913941, Metadata: map[string]interface{}{"nested": map[string]interface{}{}}},
+	}
+	for _, event := range invalid {
+		if _, err := eventService.SaveBulk([]Event{event}); !errors.As(err, &ArgumentError{}) {
+			t.Errorf("Expected event %v to be rejected with an ArgumentError, got %v", event, err)
+		}
+	}
+}
+
 func successBody(t *testing.T, event Event) error {
 	if event.UserID != "27" {
 		t.Errorf("UserID not set")
@@ -41,11 +178,82 @@ func successBody(t *testing.T, event Event) error {
 	return nil
 }
 
+func TestEventList(t *testing.T) {
+	eventService := EventService{Repository: TestEventAPI{t: t}}
+	eventList, _ := eventService.List(&User{UserID: "27", Email: "jamie@example.io"}, PageParams{})
+	if eventList.Events[0].EventName != "govent" {
+		t.Errorf("Events not listed")
+	}
+}
+
+func TestEventListParams(t *testing.T) {
+	params, _ := newEventListParams(&User{ID: "54c42e7e", UserID: "27", Email: "jamie@example.io"}, PageParams{})
+	if params.Type != "user" || params.IntercomUserID != "54c42e7e" || params.UserID != "" || params.Email != "" {
+		t.Errorf("Expected only intercom_user_id to be used, got %+v", params)
+	}
+	params, _ = newEventListParams(&User{UserID: "27", Email: "jamie@example.io"}, PageParams{})
+	if params.UserID != "27" || params.Email != "" {
+		t.Errorf("Expected only user_id to be used, got %+v", params)
+	}
+	params, _ = newEventListParams(&User{Email: "jamie@example.io"}, PageParams{})
+	if params.Email != "jamie@example.io" {
+		t.Errorf("Expected email to be used, got %+v", params)
+	}
+}
+
+func TestEventListMissingUser(t *testing.T) {
+	eventService := EventService{Repository: TestEventAPI{t: t}}
+	for _, user := range []*User{nil, &User{}} {
+		if _, err := eventService.List(user, PageParams{}); !errors.As(err, &ArgumentError{}) {
+			t.Errorf("Expected an ArgumentError listing Events for %v, got %v", user, err)
+		}
+		if _, err := eventService.Summaries(user); !errors.As(err, &ArgumentError{}) {
+			t.Errorf("Expected an ArgumentError summarising Events for %v, got %v", user, err)
+		}
+	}
+}
+
+func TestEventSummaries(t *testing.T) {
+	eventService := EventService{Repository: TestEventAPI{t: t}}
+	summaryList, err := eventService.Summaries(&User{UserID: "27"})
+	if err != nil || summaryList.Events[0].Count != 34 {
+		t.Errorf("Event summaries not listed, %v", err)
+	}
+}
+
+func TestEventSummariesEmpty(t *testing.T) {
+	eventService := EventService{Repository: TestEventAPI{t: t}}
+	summaryList, err := eventService.Summaries(&User{UserID: "no-events"})
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if summaryList.Events == nil || len(summaryList.Events) != 0 {
+		t.Errorf("Expected an empty summary, got %v", summaryList.Events)
+	}
+}
+
 type TestEventAPI struct {
 	t    *testing.T
 	body func(*testing.T, Event) error
 }
 
-func (t TestEventAPI) save(event *Event) error {
+func (t TestEventAPI) Save(event *Event) error {
 	return t.body(t.t, *event)
 }
+
+func (t TestEventAPI) List(params EventListParams) (EventList, error) {
+	if params.UserID != "27" {
+		t.t.Errorf("UserID was %s, expected 27", params.UserID)
+	}
+	return EventList{Events: []Event{Event{EventName: "govent", UserID: params.UserID}}}, nil
+}
+
+func (t TestEventAPI) Summaries(params EventListParams) (EventSummaryList, error) {
+	if !params.Summary {
+		t.t.Errorf("Summary was not requested")
+	}
+	if params.UserID == "no-events" {
+		return EventSummaryList{UserID: params.UserID}, nil
+	}
+	return EventSummaryList{UserID: params.UserID, Events: []EventSummary{EventSummary{Name: "logged-in", Count: 34}}}, nil
+}
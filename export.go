@@ -0,0 +1,106 @@
+package intercom
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Export job statuses, for ExportJob.Status.
+const (
+	ExportPending    = "pending"
+	ExportInProgress = "in_progress"
+	ExportCompleted  = "completed"
+	ExportFailed     = "failed"
+	ExportCancelled  = "cancelled"
+	ExportNoData     = "no_data"
+)
+
+// ExportService handles interactions with the API through an ExportRepository.
+type ExportService struct {
+	Repository ExportRepository
+}
+
+// ExportJob is a job exporting the App's conversation content.
+type ExportJob struct {
+	JobIdentifier     string `json:"job_identifier"`
+	Status            string `json:"status"`
+	DownloadURL       string `json:"download_url"`
+	DownloadExpiresAt string `json:"download_expires_at"`
+}
+
+type requestExport struct {
+	CreatedAtAfter  int64 `json:"created_at_after"`
+	CreatedAtBefore int64 `json:"created_at_before"`
+}
+
+// Create an ExportJob for content created between two unix timestamps.
+func (e *ExportService) Create(createdAtAfter, createdAtBefore int64) (ExportJob, error) {
+	if createdAtAfter >= createdAtBefore {
+		return ExportJob{}, errors.New("Invalid Export Range")
+	}
+	return e.Repository.create(&requestExport{CreatedAtAfter: createdAtAfter, CreatedAtBefore: createdAtBefore})
+}
+
+// Status finds an ExportJob, to check its Status.
+func (e *ExportService) Status(jobID string) (ExportJob, error) {
+	if jobID == "" {
+		return ExportJob{}, missing("Export Job ID")
+	}
+	return e.Repository.find(jobID)
+}
+
+// Cancel an ExportJob.
+func (e *ExportService) Cancel(jobID string) (ExportJob, error) {
+	if jobID == "" {
+		return ExportJob{}, missing("Export Job ID")
+	}
+	return e.Repository.cancel(jobID)
+}
+
+// Download a completed ExportJob, streaming the gzipped export to w.
+func (e *ExportService) Download(jobID string, w io.Writer) error {
+	if jobID == "" {
+		return missing("Export Job ID")
+	}
+	body, err := e.Repository.download(jobID)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	_, err = io.Copy(w, body)
+	return err
+}
+
+// DownloadCSV downloads a completed ExportJob, streaming the uncompressed CSV to w.
+func (e *ExportService) DownloadCSV(jobID string, w io.Writer) error {
+	if jobID == "" {
+		return missing("Export Job ID")
+	}
+	body, err := e.Repository.download(jobID)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+	_, err = io.Copy(w, gz)
+	return err
+}
+
+// Done reports whether the ExportJob has reached a final Status.
+func (j ExportJob) Done() bool {
+	switch j.Status {
+	case ExportCompleted, ExportFailed, ExportCancelled, ExportNoData:
+		return true
+	}
+	return false
+}
+
+func (j ExportJob) String() string {
+	return fmt.Sprintf("[intercom] export { job_identifier: %s, status: %s }", j.JobIdentifier, j.Status)
+}
@@ -0,0 +1,62 @@
+package intercom
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// ExportRepository defines the interface for working with ExportJobs through the API.
+type ExportRepository interface {
+	create(*requestExport) (ExportJob, error)
+	find(jobID string) (ExportJob, error)
+	cancel(jobID string) (ExportJob, error)
+	download(jobID string) (io.ReadCloser, error)
+}
+
+// ExportAPI implements ExportRepository
+type ExportAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+func (api ExportAPI) create(export *requestExport) (ExportJob, error) {
+	data, err := api.httpClient.Post("/export/content/data", export)
+	if err != nil {
+		return ExportJob{}, err
+	}
+	return api.unmarshal("/export/content/data", data)
+}
+
+func (api ExportAPI) find(jobID string) (ExportJob, error) {
+	uri := fmt.Sprintf("/export/content/data/%s", jobID)
+	data, err := api.httpClient.Get(uri, nil)
+	if err != nil {
+		return ExportJob{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api ExportAPI) cancel(jobID string) (ExportJob, error) {
+	uri := fmt.Sprintf("/export/cancel/%s", jobID)
+	data, err := api.httpClient.Post(uri, nil)
+	if err != nil {
+		return ExportJob{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api ExportAPI) download(jobID string) (io.ReadCloser, error) {
+	streamClient, ok := currentHTTPClient(api.httpClient).(interfaces.HTTPStreamClient)
+	if !ok {
+		return nil, errors.New("HTTP Client Does Not Support Streaming")
+	}
+	return streamClient.GetStream(fmt.Sprintf("/download/content/data/%s", jobID), nil)
+}
+
+func (api ExportAPI) unmarshal(uri string, data []byte) (ExportJob, error) {
+	job := ExportJob{}
+	err := unmarshal(uri, data, &job)
+	return job, err
+}
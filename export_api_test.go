@@ -0,0 +1,69 @@
+package intercom
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+func TestAPICreateExport(t *testing.T) {
+	http := TestExportHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/export.json", expectedURI: "/export/content/data"}
+	api := ExportAPI{httpClient: &http}
+	job, err := api.create(&requestExport{CreatedAtAfter: 1719474966, CreatedAtBefore: 1719492966})
+	if err != nil {
+		t.Fatalf("Error creating export: %v", err)
+	}
+	if job.JobIdentifier != "orzzsbd7hk67xyu" || job.Status != ExportPending || job.Done() {
+		t.Errorf("Export job was not parsed, got %v", job)
+	}
+	b, _ := json.Marshal(http.lastRequest)
+	if string(b) != `{"created_at_after":1719474966,"created_at_before":1719492966}` {
+		t.Errorf("Request was %s", b)
+	}
+}
+
+func TestAPIDownloadExport(t *testing.T) {
+	http := TestExportHTTPClient{t: t, expectedURI: "/download/content/data/orzzsbd7hk67xyu", stream: []byte("gzipped")}
+	api := ExportAPI{httpClient: &http}
+	body, err := api.download("orzzsbd7hk67xyu")
+	if err != nil {
+		t.Fatalf("Error downloading export: %v", err)
+	}
+	defer body.Close()
+	if b, _ := ioutil.ReadAll(body); string(b) != "gzipped" {
+		t.Errorf("Body was %s", b)
+	}
+}
+
+func TestAPIDownloadExportWithoutStreaming(t *testing.T) {
+	api := ExportAPI{httpClient: TestHTTPClient{}}
+	if _, err := api.download("orzzsbd7hk67xyu"); err == nil {
+		t.Errorf("Expected an error from a HTTP Client without streaming")
+	}
+}
+
+type TestExportHTTPClient struct {
+	TestHTTPClient
+	t               *testing.T
+	fixtureFilename string
+	expectedURI     string
+	lastRequest     interface{}
+	stream          []byte
+}
+
+func (t *TestExportHTTPClient) Post(uri string, body interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	t.lastRequest = body
+	return ioutil.ReadFile(t.fixtureFilename)
+}
+
+func (t *TestExportHTTPClient) GetStream(uri string, params interface{}) (io.ReadCloser, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	return ioutil.NopCloser(bytes.NewReader(t.stream)), nil
+}
@@ -0,0 +1,66 @@
+package intercom
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+func TestCreateExportInvalidRange(t *testing.T) {
+	exportService := ExportService{Repository: TestExportAPI{}}
+	if _, err := exportService.Create(1719492966, 1719474966); err == nil {
+		t.Errorf("Expected an error for an invalid range")
+	}
+}
+
+func TestDownloadExportCSV(t *testing.T) {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	gz.Write([]byte("conversation_id,created_at\n494,1719493013\n"))
+	gz.Close()
+
+	exportService := ExportService{Repository: TestExportAPI{archive: buf.Bytes()}}
+	out := &bytes.Buffer{}
+	if err := exportService.DownloadCSV("orzzsbd7hk67xyu", out); err != nil {
+		t.Fatalf("Error downloading export: %v", err)
+	}
+	if out.String() != "conversation_id,created_at\n494,1719493013\n" {
+		t.Errorf("Export was %q", out.String())
+	}
+
+	out.Reset()
+	exportService.Download("orzzsbd7hk67xyu", out)
+	if !bytes.Equal(out.Bytes(), buf.Bytes()) {
+		t.Errorf("Download should not decompress the export")
+	}
+}
+
+func TestExportJobDone(t *testing.T) {
+	for status, done := range map[string]bool{ExportPending: false, ExportInProgress: false, ExportCompleted: true, ExportFailed: true, ExportNoData: true} {
+		if (ExportJob{Status: status}).Done() != done {
+			t.Errorf("Export with status %s should have Done %t", status, done)
+		}
+	}
+}
+
+type TestExportAPI struct {
+	archive []byte
+}
+
+func (t TestExportAPI) create(export *requestExport) (ExportJob, error) {
+	return ExportJob{Status: ExportPending}, nil
+}
+
+func (t TestExportAPI) find(jobID string) (ExportJob, error) {
+	return ExportJob{JobIdentifier: jobID}, nil
+}
+
+func (t TestExportAPI) cancel(jobID string) (ExportJob, error) {
+	return ExportJob{JobIdentifier: jobID, Status: ExportCancelled}, nil
+}
+
+func (t TestExportAPI) download(jobID string) (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(t.archive)), nil
+}
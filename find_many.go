@@ -0,0 +1,55 @@
+package intercom
+
+import "sync"
+
+const defaultFindConcurrency = 4
+
+// FindMany finds the Conversations with the ids, making up to concurrency requests at once (4 if 0).
+// Repeated ids are found once. Rate limited requests are retried as the Client's RetryRequests or
+// RetryPolicy say, waiting as long as the rate limit does.
+// Each id is in one of the maps: the Conversations found, or the errors finding the others.
+func (c *ConversationService) FindMany(ids []string, concurrency int) (map[string]Conversation, map[string]error) {
+	return findMany(ids, concurrency, c.Find)
+}
+
+// FindMany finds the Users with the Intercom ids, making up to concurrency requests at once (4 if 0).
+// Repeated ids are found once. Rate limited requests are retried as the Client's RetryRequests or
+// RetryPolicy say, waiting as long as the rate limit does.
+// Each id is in one of the maps: the Users found, or the errors finding the others.
+func (u *UserService) FindMany(ids []string, concurrency int) (map[string]User, map[string]error) {
+	return findMany(ids, concurrency, u.FindByID)
+}
+
+func findMany[T any](ids []string, concurrency int, find func(id string) (T, error)) (map[string]T, map[string]error) {
+	if concurrency <= 0 {
+		concurrency = defaultFindConcurrency
+	}
+	found := map[string]T{}
+	errs := map[string]error{}
+	seen := map[string]bool{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			v, err := find(id)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[id] = err
+				return
+			}
+			found[id] = v
+		}(id)
+	}
+	wg.Wait()
+	return found, errs
+}
@@ -0,0 +1,110 @@
+package intercom
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+func TestConversationFindMany(t *testing.T) {
+	repo := &TestManyConversationAPI{rateLimited: map[string]int{"2": 1}}
+	conversationService := ConversationService{Repository: repo}
+	found, errs := conversationService.FindMany([]string{"1", "2", "1", "missing", "3", "4", "5"}, 2)
+	if len(found) != 4 || found["3"].ID != "3" {
+		t.Errorf("Expected 4 Conversations, got %v", found)
+	}
+	if len(errs) != 2 || !errors.Is(errs["missing"], ErrNotFound) || !errors.Is(errs["2"], ErrRateLimited) {
+		t.Errorf("Expected not found and rate limited errors, got %v", errs)
+	}
+	if repo.found["1"] != 1 {
+		t.Errorf("Expected the repeated id to be found once, was found %d times", repo.found["1"])
+	}
+	if repo.maxInFlight > 2 {
+		t.Errorf("Expected at most 2 requests at once, got %d", repo.maxInFlight)
+	}
+}
+
+func TestConversationFindManyRetries(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		limited := r.URL.Path == "/conversations/2" && requests[r.URL.Path] == 1
+		mu.Unlock()
+		if limited {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprintf(w, `{"type": "conversation", "id": %q}`, strings.TrimPrefix(r.URL.Path, "/conversations/"))
+	}))
+	defer server.Close()
+
+	clock := &testClock{now: time.Unix(1500000000, 0)}
+	ic := NewClient("app_id", "api_key", BaseURI(server.URL), SetClock(clock), RetryRequests(interfaces.RetryOptions{MaxAttempts: 3, MaxDelay: time.Minute}))
+	found, errs := ic.Conversations.FindMany([]string{"1", "2", "3"}, 2)
+	if len(found) != 3 || len(errs) != 0 {
+		t.Errorf("Expected 3 Conversations, got %v and errors %v", found, errs)
+	}
+	if requests["/conversations/2"] != 2 || len(clock.waits) != 1 || clock.waits[0] != 30*time.Second {
+		t.Errorf("Expected the rate limited request to be retried once by the Client after 30s, got %d requests and waits %v", requests["/conversations/2"], clock.waits)
+	}
+}
+
+func TestUserFindMany(t *testing.T) {
+	userService := UserService{Repository: TestUserAPI{t: t}}
+	found, errs := userService.FindMany([]string{"46adad3f09126dca", ""}, 0)
+	if found["46adad3f09126dca"].ID != "46adad3f09126dca" {
+		t.Errorf("Expected the User to be found, got %v", found)
+	}
+	var argumentErr ArgumentError
+	if !errors.As(errs[""], &argumentErr) {
+		t.Errorf("Expected an ArgumentError for the empty id, got %v", errs)
+	}
+}
+
+type TestManyConversationAPI struct {
+	TestConversationAPI
+	mu          sync.Mutex
+	found       map[string]int
+	rateLimited map[string]int
+	inFlight    int
+	maxInFlight int
+}
+
+func (t *TestManyConversationAPI) Find(id string) (Conversation, error) {
+	t.mu.Lock()
+	t.inFlight++
+	if t.inFlight > t.maxInFlight {
+		t.maxInFlight = t.inFlight
+	}
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		t.inFlight--
+		t.mu.Unlock()
+	}()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.rateLimited[id] > 0 {
+		t.rateLimited[id]--
+		return Conversation{}, interfaces.HTTPError{StatusCode: 429, Code: "rate_limit_exceeded"}
+	}
+	if id == "missing" {
+		return Conversation{}, interfaces.HTTPError{StatusCode: 404, Code: "not_found"}
+	}
+	if t.found == nil {
+		t.found = map[string]int{}
+	}
+	t.found[id]++
+	return Conversation{ID: id}, nil
+}
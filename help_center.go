@@ -0,0 +1,55 @@
+package intercom
+
+import "fmt"
+
+// HelpCenterService handles interactions with the API through a HelpCenterRepository.
+type HelpCenterService struct {
+	Repository HelpCenterRepository
+}
+
+// HelpCenter represents one of the App's Help Centers.
+type HelpCenter struct {
+	ID              string `json:"id"`
+	WorkspaceID     string `json:"workspace_id"`
+	Identifier      string `json:"identifier"`
+	WebsiteTurnedOn bool   `json:"website_turned_on"`
+	DisplayName     string `json:"display_name"`
+	CreatedAt       int64  `json:"created_at"`
+	UpdatedAt       int64  `json:"updated_at"`
+}
+
+// HelpCenterList holds a list of HelpCenters
+type HelpCenterList struct {
+	HelpCenters []HelpCenter `json:"data"`
+}
+
+// List all HelpCenters for the App
+func (h *HelpCenterService) List() (HelpCenterList, error) {
+	return h.Repository.list()
+}
+
+// Find a HelpCenter by its ID.
+func (h *HelpCenterService) Find(id string) (HelpCenter, error) {
+	if id == "" {
+		return HelpCenter{}, missing("Help Center ID")
+	}
+	return h.Repository.find(id)
+}
+
+// FindByIdentifier finds a HelpCenter in the HelpCenterList by its Identifier.
+// It is safe to call on a nil HelpCenterList.
+func (l *HelpCenterList) FindByIdentifier(identifier string) (HelpCenter, bool) {
+	if l == nil {
+		return HelpCenter{}, false
+	}
+	for _, helpCenter := range l.HelpCenters {
+		if helpCenter.Identifier == identifier {
+			return helpCenter, true
+		}
+	}
+	return HelpCenter{}, false
+}
+
+func (h HelpCenter) String() string {
+	return fmt.Sprintf("[intercom] help_center { id: %s, identifier: %s }", h.ID, h.Identifier)
+}
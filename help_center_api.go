@@ -0,0 +1,39 @@
+package intercom
+
+import (
+	"fmt"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// HelpCenterRepository defines the interface for working with HelpCenters through the API.
+type HelpCenterRepository interface {
+	list() (HelpCenterList, error)
+	find(id string) (HelpCenter, error)
+}
+
+// HelpCenterAPI implements HelpCenterRepository
+type HelpCenterAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+func (api HelpCenterAPI) list() (HelpCenterList, error) {
+	helpCenterList := HelpCenterList{}
+	data, err := api.httpClient.Get("/help_center/help_centers", nil)
+	if err != nil {
+		return helpCenterList, err
+	}
+	err = unmarshal("/help_center/help_centers", data, &helpCenterList)
+	return helpCenterList, err
+}
+
+func (api HelpCenterAPI) find(id string) (HelpCenter, error) {
+	helpCenter := HelpCenter{}
+	uri := fmt.Sprintf("/help_center/help_centers/%s", id)
+	data, err := api.httpClient.Get(uri, nil)
+	if err != nil {
+		return helpCenter, err
+	}
+	err = unmarshal(uri, data, &helpCenter)
+	return helpCenter, err
+}
@@ -0,0 +1,28 @@
+package intercom
+
+import "testing"
+
+func TestAPIListHelpCenters(t *testing.T) {
+	http := TestTeamHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/help_centers.json", expectedURI: "/help_center/help_centers"}
+	api := HelpCenterAPI{httpClient: &http}
+	helpCenterList, err := api.list()
+	if err != nil {
+		t.Fatalf("Error listing help centers: %v", err)
+	}
+	helpCenter, ok := helpCenterList.FindByIdentifier("help-center-partners")
+	if !ok || helpCenter.ID != "15" || helpCenter.WebsiteTurnedOn {
+		t.Errorf("Help center was not found by identifier, got %v", helpCenter)
+	}
+}
+
+func TestAPIFindHelpCenter(t *testing.T) {
+	http := TestTeamHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/help_center.json", expectedURI: "/help_center/help_centers/14"}
+	api := HelpCenterAPI{httpClient: &http}
+	helpCenter, err := api.find("14")
+	if err != nil {
+		t.Fatalf("Error finding help center: %v", err)
+	}
+	if helpCenter.DisplayName != "Customers" || !helpCenter.WebsiteTurnedOn {
+		t.Errorf("Help center was not parsed, got %v", helpCenter)
+	}
+}
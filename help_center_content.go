@@ -0,0 +1,34 @@
+package intercom
+
+import "encoding/json"
+
+// GroupContent is the content of a Help Center Collection or Section in one locale.
+type GroupContent struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// GroupTranslatedContent holds the GroupContent of a Collection or Section by locale, such as "fr".
+type GroupTranslatedContent map[string]GroupContent
+
+// UnmarshalJSON decodes translated content, skipping its "type" field and
+// any locales without content.
+func (t *GroupTranslatedContent) UnmarshalJSON(data []byte) error {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	content := GroupTranslatedContent{}
+	for locale, value := range raw {
+		if locale == "type" || string(value) == "null" {
+			continue
+		}
+		groupContent := GroupContent{}
+		if err := json.Unmarshal(value, &groupContent); err != nil {
+			return err
+		}
+		content[locale] = groupContent
+	}
+	*t = content
+	return nil
+}
@@ -0,0 +1,36 @@
+package intercom
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ID is the ID of an Intercom object, such as an Admin, Tag or Segment. The API sends some IDs
+// as JSON strings and others as numbers, depending on the object and API version, so ID decodes either.
+type ID string
+
+func (id ID) String() string {
+	return string(id)
+}
+
+// UnmarshalJSON decodes an ID from a JSON string or number.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*id = ID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("Invalid ID %s, expected a string or number", data)
+	}
+	*id = ID(n)
+	return nil
+}
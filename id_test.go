@@ -0,0 +1,31 @@
+package intercom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIDUnmarshalJSON(t *testing.T) {
+	admin := Admin{}
+	if err := json.Unmarshal([]byte(`{"id": 814860, "team_ids": ["2", 3]}`), &admin); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if admin.ID != "814860" || len(admin.TeamIDs) != 2 || admin.TeamIDs[0] != "2" || admin.TeamIDs[1] != "3" {
+		t.Errorf("Admin was %+v", admin)
+	}
+	tag := Tag{}
+	if err := json.Unmarshal([]byte(`{"id": 17513, "name": "VIP"}`), &tag); err != nil || tag.ID != "17513" {
+		t.Errorf("Tag was %+v, %v", tag, err)
+	}
+	segment := Segment{}
+	if err := json.Unmarshal([]byte(`{"id": "5310d8e7598c9a0b24000002"}`), &segment); err != nil || segment.ID != "5310d8e7598c9a0b24000002" {
+		t.Errorf("Segment was %+v, %v", segment, err)
+	}
+	ticket := Ticket{}
+	if err := json.Unmarshal([]byte(`{"admin_assignee_id": 0, "team_assignee_id": null}`), &ticket); err != nil || ticket.AdminAssigneeID != "0" || ticket.TeamAssigneeID != "" {
+		t.Errorf("Ticket was %+v, %v", ticket, err)
+	}
+	if err := json.Unmarshal([]byte(`{"id": {"value": 1}}`), &admin); err == nil {
+		t.Errorf("Expected an error decoding an object as an ID")
+	}
+}
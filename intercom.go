@@ -1,34 +1,70 @@
 package intercom
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
 	"gopkg.in/intercom/intercom-go.v2/interfaces"
 )
 
 // A Client manages interacting with the Intercom API.
 type Client struct {
 	// Services for interacting with various resources in Intercom.
-	Admins        AdminService
-	Companies     CompanyService
-	Contacts      ContactService
-	Conversations ConversationService
-	Events        EventService
-	Jobs          JobService
-	Messages      MessageService
-	Segments      SegmentService
-	Tags          TagService
-	Users         UserService
+	Admins             AdminService
+	Articles           ArticleService
+	Collections        CollectionService
+	Companies          CompanyService
+	Contacts           ContactService
+	Counts             CountService
+	Conversations      ConversationService
+	DataAttributes     DataAttributeService
+	Events             EventService
+	Exports            ExportService
+	HelpCenters        HelpCenterService
+	Jobs               JobService
+	Messages           MessageService
+	NewsItems          NewsItemService
+	Notes              NoteService
+	PhoneCallRedirects PhoneCallRedirectService
+	Sections           SectionService
+	Segments           SegmentService
+	SubscriptionTypes  SubscriptionTypeService
+	Subscriptions      SubscriptionService
+	Tags               TagService
+	Teams              TeamService
+	TicketTypes        TicketTypeService
+	Tickets            TicketService
+	Users              UserService
 
 	// Mappings for resources to API constructs
-	AdminRepository        AdminRepository
-	CompanyRepository      CompanyRepository
-	ContactRepository      ContactRepository
-	ConversationRepository ConversationRepository
-	EventRepository        EventRepository
-	JobRepository          JobRepository
-	MessageRepository      MessageRepository
-	SegmentRepository      SegmentRepository
-	TagRepository          TagRepository
-	UserRepository         UserRepository
+	AdminRepository             AdminRepository
+	ArticleRepository           ArticleRepository
+	CollectionRepository        CollectionRepository
+	CompanyRepository           CompanyRepository
+	ContactRepository           ContactRepository
+	CountRepository             CountRepository
+	ConversationRepository      ConversationRepository
+	DataAttributeRepository     DataAttributeRepository
+	EventRepository             EventRepository
+	ExportRepository            ExportRepository
+	HelpCenterRepository        HelpCenterRepository
+	JobRepository               JobRepository
+	MessageRepository           MessageRepository
+	NewsItemRepository          NewsItemRepository
+	NoteRepository              NoteRepository
+	PhoneCallRedirectRepository PhoneCallRedirectRepository
+	SectionRepository           SectionRepository
+	SegmentRepository           SegmentRepository
+	SubscriptionTypeRepository  SubscriptionTypeRepository
+	SubscriptionRepository      SubscriptionRepository
+	TagRepository               TagRepository
+	TeamRepository              TeamRepository
+	TicketTypeRepository        TicketTypeRepository
+	TicketRepository            TicketRepository
+	UserRepository              UserRepository
 
 	// AppID For Intercom.
 	AppID string
@@ -39,9 +75,23 @@ type Client struct {
 	// HTTP Client used to interact with the API.
 	HTTPClient interfaces.HTTPClient
 
+	accessToken   *interfaces.AccessToken
 	baseURI       string
 	clientVersion string
 	debug         bool
+	apiVersion    string
+	retry         interfaces.RetryOptions
+	retryPolicy   interfaces.RetryPolicy
+	clock         interfaces.Clock
+	throttle      *interfaces.Throttle
+	hooks         interfaces.Hooks
+	idempotent    bool
+	timeout       time.Duration
+	userAgent     string
+	dump          io.Writer
+	metrics       interfaces.Metrics
+	headers       http.Header
+	settings      *sync.RWMutex
 }
 
 const (
@@ -49,24 +99,166 @@ const (
 	clientVersion  = "2.0.0"
 )
 
+// Region is a region Intercom hosts Apps in, each with its own API host.
+type Region string
+
+// Regions for SetRegion. Apps are in RegionUS unless set up elsewhere.
+const (
+	RegionUS Region = "us"
+	RegionEU Region = "eu"
+	RegionAU Region = "au"
+)
+
+// BaseURI is the base URI of the Region's API host.
+func (r Region) BaseURI() string {
+	switch r {
+	case RegionEU:
+		return "https://api.eu.intercom.io"
+	case RegionAU:
+		return "https://api.au.intercom.io"
+	}
+	return defaultBaseURI
+}
+
+// Intercom API versions, for SetAPIVersion, which the structs in this package are tested against.
+// Users, Events and bulk Jobs are only in 1.x; Tickets, News items and other newer resources are only in 2.x.
+const (
+	APIVersion1_4  = "1.4"
+	APIVersion2_11 = "2.11"
+)
+
 type option func(c *Client) option
 
-// Set Options on the Intercom Client, see TraceHTTP, BaseURI and SetHTTPClient.
+// Set Options on the Intercom Client, see TraceHTTP, DumpHTTP, BaseURI, SetRegion, SetAPIVersion, SetUserAgent,
+// AppendUserAgent, DefaultHeaders, RequestTimeout, RetryRequests, SetRetryPolicy, ThrottleRequests, IdempotencyKeys,
+// OnRequest, OnResponse, ObserveRequests, SetClock, SetNetHTTPClient, SetTransport and SetHTTPClient.
+//
+// Options can be set while requests are being made, which pick them up when they start.
 func (c *Client) Option(opts ...option) (previous option) {
+	if c.settings != nil {
+		c.settings.Lock()
+		defer c.settings.Unlock()
+	}
 	for _, opt := range opts {
 		previous = opt(c)
 	}
 	return previous
 }
 
-// NewClient returns a new Intercom API client, configured with the default HTTPClient.
-func NewClient(appID, apiKey string) *Client {
-	intercom := Client{AppID: appID, APIKey: apiKey, baseURI: defaultBaseURI, debug: false, clientVersion: clientVersion}
-	intercom.HTTPClient = interfaces.NewIntercomHTTPClient(intercom.AppID, intercom.APIKey, &intercom.baseURI, &intercom.clientVersion, &intercom.debug)
+// NewClient returns a new Intercom API client, configured with the default HTTPClient and then any options:
+//
+//	ic := intercom.NewClient("appID", "apiKey", intercom.SetRegion(intercom.RegionEU), intercom.SetHTTPClient(myHTTPClient))
+func NewClient(appID, apiKey string, opts ...option) *Client {
+	intercom := Client{AppID: appID, APIKey: apiKey, accessToken: &interfaces.AccessToken{}, baseURI: defaultBaseURI, debug: false, clientVersion: clientVersion, throttle: &interfaces.Throttle{}, settings: &sync.RWMutex{}}
+	httpClient := interfaces.NewIntercomHTTPClient(intercom.AppID, intercom.APIKey, &intercom.baseURI, &intercom.clientVersion, &intercom.debug)
+	httpClient.AccessToken = intercom.accessToken
+	httpClient.APIVersion = &intercom.apiVersion
+	httpClient.Retry = &intercom.retry
+	httpClient.RetryPolicy = &intercom.retryPolicy
+	httpClient.Clock = &intercom.clock
+	httpClient.Throttle = intercom.throttle
+	httpClient.Hooks = &intercom.hooks
+	httpClient.IdempotencyKeys = &intercom.idempotent
+	httpClient.Timeout = &intercom.timeout
+	httpClient.UserAgent = &intercom.userAgent
+	httpClient.Dump = &intercom.dump
+	httpClient.Metrics = &intercom.metrics
+	httpClient.DefaultHeaders = &intercom.headers
+	httpClient.SettingsLock = intercom.settings
+	intercom.HTTPClient = httpClient
 	intercom.setup()
+	intercom.Option(opts...)
 	return &intercom
 }
 
+// NewOAuthClient returns a new Intercom API client which authenticates with an OAuth access token,
+// sent as a Bearer token, configured with the default HTTPClient and then any options.
+func NewOAuthClient(accessToken string, opts ...option) *Client {
+	intercom := NewClient("", "", opts...)
+	intercom.SetAccessToken(accessToken)
+	return intercom
+}
+
+// SetAccessToken sets the OAuth access token the default HTTPClient authenticates with,
+// instead of the AppID and APIKey. It is safe to call while requests are being made,
+// for example when rotating tokens; requests already made keep the previous token.
+func (c *Client) SetAccessToken(accessToken string) {
+	if c.accessToken == nil {
+		c.accessToken = &interfaces.AccessToken{}
+		if httpClient, ok := c.HTTPClient.(interfaces.IntercomHTTPClient); ok {
+			httpClient.AccessToken = c.accessToken
+			c.HTTPClient = httpClient
+			c.setup()
+		}
+	}
+	c.accessToken.Set(accessToken)
+}
+
+// WithContext returns a copy of the Client whose requests are made with ctx,
+// so they are cancelled, or time out, along with it:
+//
+//	user, err := ic.WithContext(ctx).Users.FindByEmail("bob@example.io")
+//
+// The HTTPClient must implement interfaces.HTTPContextClient, as the default one does;
+// otherwise the copy makes its requests as the Client does.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	client := c.copy()
+	if httpClient, ok := client.HTTPClient.(interfaces.HTTPContextClient); ok {
+		client.HTTPClient = httpClient.WithContext(ctx)
+	}
+	client.setup()
+	return &client
+}
+
+// WithTimeout returns a copy of the Client whose requests time out after timeout, including any retries,
+// overriding the RequestTimeout. Requests which time out return a TimeoutError:
+//
+//	export, err := ic.WithTimeout(time.Minute).Exports.Status(jobID)
+//
+// The HTTPClient must implement interfaces.HTTPTimeoutClient, as the default one does;
+// otherwise the copy makes its requests as the Client does.
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	client := c.copy()
+	if httpClient, ok := client.HTTPClient.(interfaces.HTTPTimeoutClient); ok {
+		client.HTTPClient = httpClient.WithTimeout(timeout)
+	}
+	client.setup()
+	return &client
+}
+
+// copy copies the Client, under its settings lock so options can be set at the same time.
+func (c *Client) copy() Client {
+	if c.settings != nil {
+		c.settings.RLock()
+		defer c.settings.RUnlock()
+	}
+	return *c
+}
+
+// httpClient returns the Client's HTTPClient, read under its settings lock.
+func (c *Client) httpClient() interfaces.HTTPClient {
+	return settingsHTTPClient{client: &c.HTTPClient, settings: c.settings}.current()
+}
+
+// RateLimit returns Intercom's rate limit, as of the latest response which reported it.
+// It is zero if the HTTPClient does not implement interfaces.HTTPRateLimitClient, as the default one does.
+func (c *Client) RateLimit() interfaces.RateLimitInfo {
+	if httpClient, ok := c.httpClient().(interfaces.HTTPRateLimitClient); ok {
+		return httpClient.RateLimit()
+	}
+	return interfaces.RateLimitInfo{}
+}
+
+// Stats returns counts of the requests made by the Client, and its copies, such as how many are in flight,
+// how many were retried or rate limited, and the bytes read. They are zero if the HTTPClient does not
+// implement interfaces.HTTPStatsClient, as the default one does.
+func (c *Client) Stats() interfaces.Stats {
+	if httpClient, ok := c.httpClient().(interfaces.HTTPStatsClient); ok {
+		return httpClient.Stats()
+	}
+	return interfaces.Stats{}
+}
+
 // TraceHTTP turns on HTTP request/response tracing for debugging.
 func TraceHTTP(trace bool) option {
 	return func(c *Client) option {
@@ -76,6 +268,18 @@ func TraceHTTP(trace bool) option {
 	}
 }
 
+// DumpHTTP writes every request made by the default HTTPClient, including retries, and its response to w,
+// with their headers and bodies, for seeing exactly what was sent. The Authorization header is redacted,
+// and the bodies of streamed downloads are left out. Each request and response is written with a single Write.
+// A nil w, the default, turns it off.
+func DumpHTTP(w io.Writer) option {
+	return func(c *Client) option {
+		previous := c.dump
+		c.dump = w
+		return DumpHTTP(previous)
+	}
+}
+
 // BaseURI sets a base URI for the HTTP Client to use. Defaults to "https://api.intercom.io".
 // Typically this would be used during testing to point to a stubbed service.
 func BaseURI(baseURI string) option {
@@ -86,36 +290,329 @@ func BaseURI(baseURI string) option {
 	}
 }
 
+// SetRegion sends requests to the API host for the Region an App is hosted in.
+// Pagination links to absolute URLs are also sent to it.
+func SetRegion(region Region) option {
+	return func(c *Client) option {
+		previous := c.baseURI
+		c.baseURI = region.BaseURI()
+		return BaseURI(previous)
+	}
+}
+
+// SetAPIVersion sets the Intercom-Version header sent with every request, pinning the
+// behaviour of the API rather than following the App's default, which is used when it is empty.
+func SetAPIVersion(version string) option {
+	return func(c *Client) option {
+		previous := c.apiVersion
+		c.apiVersion = version
+		return SetAPIVersion(previous)
+	}
+}
+
+// SetUserAgent sets the User-Agent header sent with every request, including retries, made by the default HTTPClient.
+// It defaults to intercom-go/<version>, which it is reset to when empty.
+func SetUserAgent(userAgent string) option {
+	return func(c *Client) option {
+		previous := c.userAgent
+		c.userAgent = userAgent
+		return SetUserAgent(previous)
+	}
+}
+
+// AppendUserAgent adds a product, such as "my-service/1.2", to the end of the User-Agent header,
+// so Intercom can tell which service requests come from.
+func AppendUserAgent(product string) option {
+	return func(c *Client) option {
+		previous := c.userAgent
+		userAgent := previous
+		if userAgent == "" {
+			userAgent = "intercom-go/" + c.clientVersion
+		}
+		c.userAgent = userAgent + " " + product
+		return SetUserAgent(previous)
+	}
+}
+
+// RetryRequests retries requests which are rate limited, or fail with a 502, 503 or 504.
+// Retrying is off by default; see interfaces.RetryOptions. interfaces.DefaultRetryOptions are conservative,
+// making up to 3 attempts in no more than 10s:
+//
+//	ic.Option(intercom.RetryRequests(interfaces.DefaultRetryOptions()))
+func RetryRequests(retry interfaces.RetryOptions) option {
+	return func(c *Client) option {
+		previous := c.retry
+		c.retry = retry
+		return RetryRequests(previous)
+	}
+}
+
+// SetClock sets the Clock the Client tells the time and waits with, for backing off between retries,
+// throttling, polling Jobs and defaulting Events' CreatedAt, so tests can control time:
+//
+//	clock := intercomtest.NewClock(time.Unix(1500000000, 0))
+//	ic := intercom.NewClient("appID", "apiKey", intercom.SetClock(clock))
+//
+// It is interfaces.RealClock by default, or if clock is nil. Request timeouts and contexts always use real time.
+func SetClock(clock interfaces.Clock) option {
+	return func(c *Client) option {
+		previous := c.clock
+		c.clock = clock
+		c.setupWithoutSettings()
+		return SetClock(previous)
+	}
+}
+
+// SetRetryPolicy decides whether and when to retry requests with policy, instead of the RetryRequests options.
+// interfaces.RetryOptions is the default policy, which a custom one can delegate to; see interfaces.RetryPolicy.
+// A nil policy goes back to the RetryRequests options.
+func SetRetryPolicy(policy interfaces.RetryPolicy) option {
+	return func(c *Client) option {
+		previous := c.retryPolicy
+		c.retryPolicy = policy
+		return SetRetryPolicy(previous)
+	}
+}
+
+// RequestTimeout sets the time requests made by the default HTTPClient have, including any retries,
+// after which they return a TimeoutError. It can be overridden with Client.WithTimeout.
+// A timeout of 0, the default, means requests don't time out, other than by their context or http.Client.
+func RequestTimeout(timeout time.Duration) option {
+	return func(c *Client) option {
+		previous := c.timeout
+		c.timeout = timeout
+		return RequestTimeout(previous)
+	}
+}
+
+// IdempotencyKeys sends a generated Idempotency-Key header with each POST request made by the
+// default HTTPClient, which stays the same when the request is retried.
+// A key can be chosen for the requests made with a context using WithIdempotencyKey.
+func IdempotencyKeys(generate bool) option {
+	return func(c *Client) option {
+		previous := c.idempotent
+		c.idempotent = generate
+		return IdempotencyKeys(previous)
+	}
+}
+
+// DefaultHeaders sets headers sent with every request made by the default HTTPClient, such as a proxy's token.
+// Headers the client sets itself, such as Authorization, Accept and User-Agent, aren't replaced.
+// Headers can also be sent with the requests made with a context, using WithHeaders.
+func DefaultHeaders(header http.Header) option {
+	return func(c *Client) option {
+		previous := c.headers
+		c.headers = header.Clone()
+		return DefaultHeaders(previous)
+	}
+}
+
+// WithHeaders returns a copy of ctx whose requests send header too, for use with Client.WithContext,
+// such as to correlate the requests of one operation. They take precedence over DefaultHeaders.
+func WithHeaders(ctx context.Context, header http.Header) context.Context {
+	return interfaces.WithHeaders(ctx, header)
+}
+
+// WithIdempotencyKey returns a copy of ctx whose POST requests send key as their Idempotency-Key header,
+// for use with Client.WithContext.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return interfaces.WithIdempotencyKey(ctx, key)
+}
+
+// OnRequest sets a function called before every attempt at a request made by the default HTTPClient,
+// including retries, with a copy of the request.
+func OnRequest(fn func(*http.Request)) option {
+	return func(c *Client) option {
+		previous := c.hooks.OnRequest
+		c.hooks.OnRequest = fn
+		return OnRequest(previous)
+	}
+}
+
+// OnResponse sets a function called after every attempt at a request made by the default HTTPClient,
+// including retries, with how long it took. The response is a copy without its body, but with its headers,
+// such as the X-Request-Id Intercom's support ask for, and is nil if there was an error instead.
+// Errors from the API have the X-Request-Id too, as their RequestID.
+func OnResponse(fn func(*http.Request, *http.Response, time.Duration, error)) option {
+	return func(c *Client) option {
+		previous := c.hooks.OnResponse
+		c.hooks.OnResponse = fn
+		return OnResponse(previous)
+	}
+}
+
+// ObserveRequests reports every attempt at a request made by the default HTTPClient, including retries,
+// to metrics, such as a Prometheus collector. A nil metrics, the default, turns it off.
+func ObserveRequests(metrics interfaces.Metrics) option {
+	return func(c *Client) option {
+		previous := c.metrics
+		c.metrics = metrics
+		return ObserveRequests(previous)
+	}
+}
+
+// SetNetHTTPClient sets the *http.Client used by the default HTTPClient, for configuring proxies,
+// TLS, timeouts and so on. Its settings are used as they are. It has no effect on other HTTPClients.
+func SetNetHTTPClient(client *http.Client) option {
+	return func(c *Client) option {
+		httpClient, ok := c.HTTPClient.(interfaces.IntercomHTTPClient)
+		if !ok {
+			return SetNetHTTPClient(client)
+		}
+		previous := httpClient.Client
+		httpClient.Client = client
+		c.HTTPClient = httpClient
+		c.setupWithoutSettings()
+		return SetNetHTTPClient(previous)
+	}
+}
+
+// SetTransport sets the http.RoundTripper used by the default HTTPClient, keeping the rest of its *http.Client.
+// It has no effect on other HTTPClients.
+func SetTransport(transport http.RoundTripper) option {
+	return func(c *Client) option {
+		httpClient, ok := c.HTTPClient.(interfaces.IntercomHTTPClient)
+		if !ok {
+			return SetTransport(transport)
+		}
+		client := *httpClient.Client
+		previous := client.Transport
+		client.Transport = transport
+		httpClient.Client = &client
+		c.HTTPClient = httpClient
+		c.setupWithoutSettings()
+		return SetTransport(previous)
+	}
+}
+
+// ThrottleRequests limits the rate of requests made by the default HTTPClient, across all services,
+// to requestsPerMinute, in bursts of up to burst requests. Requests wait for their turn,
+// unless their context is done first. A requestsPerMinute of 0, the default, turns it off.
+func ThrottleRequests(requestsPerMinute, burst int) option {
+	return func(c *Client) option {
+		if c.throttle == nil {
+			c.throttle = &interfaces.Throttle{}
+		}
+		previousRate, previousBurst := c.throttle.Rate()
+		c.throttle.SetRate(requestsPerMinute, burst)
+		return ThrottleRequests(previousRate, previousBurst)
+	}
+}
+
 // SetHTTPClient sets a HTTPClient for the Intercom Client to use.
 // Useful for customising timeout behaviour etc.
 func SetHTTPClient(httpClient interfaces.HTTPClient) option {
 	return func(c *Client) option {
 		previous := c.HTTPClient
 		c.HTTPClient = httpClient
-		c.setup()
+		c.setupWithoutSettings()
 		return SetHTTPClient(previous)
 	}
 }
 
+// setup makes the repositories and services. Those of a Client made with NewClient read its HTTPClient and Clock
+// under the settings lock, so options can replace them while requests are being made.
 func (c *Client) setup() {
-	c.AdminRepository = AdminAPI{httpClient: c.HTTPClient}
-	c.CompanyRepository = CompanyAPI{httpClient: c.HTTPClient}
-	c.ContactRepository = ContactAPI{httpClient: c.HTTPClient}
-	c.ConversationRepository = ConversationAPI{httpClient: c.HTTPClient}
-	c.EventRepository = EventAPI{httpClient: c.HTTPClient}
-	c.JobRepository = JobAPI{httpClient: c.HTTPClient}
-	c.MessageRepository = MessageAPI{httpClient: c.HTTPClient}
-	c.SegmentRepository = SegmentAPI{httpClient: c.HTTPClient}
-	c.TagRepository = TagAPI{httpClient: c.HTTPClient}
-	c.UserRepository = UserAPI{httpClient: c.HTTPClient}
+	var httpClient interfaces.HTTPClient = settingsHTTPClient{client: &c.HTTPClient, settings: c.settings}
+	var clock interfaces.Clock = settingsClock{clock: &c.clock, settings: c.settings}
+	if c.settings == nil {
+		httpClient, clock = c.HTTPClient, c.clock
+	}
+	c.AdminRepository = AdminAPI{httpClient: httpClient}
+	c.ArticleRepository = ArticleAPI{httpClient: httpClient}
+	c.CollectionRepository = CollectionAPI{httpClient: httpClient}
+	c.CompanyRepository = CompanyAPI{httpClient: httpClient}
+	c.ContactRepository = ContactAPI{httpClient: httpClient}
+	c.CountRepository = CountAPI{httpClient: httpClient}
+	c.ConversationRepository = ConversationAPI{httpClient: httpClient}
+	c.DataAttributeRepository = DataAttributeAPI{httpClient: httpClient}
+	c.EventRepository = EventAPI{httpClient: httpClient}
+	c.ExportRepository = ExportAPI{httpClient: httpClient}
+	c.HelpCenterRepository = HelpCenterAPI{httpClient: httpClient}
+	c.JobRepository = JobAPI{httpClient: httpClient}
+	c.MessageRepository = MessageAPI{httpClient: httpClient}
+	c.NewsItemRepository = NewsItemAPI{httpClient: httpClient}
+	c.NoteRepository = NoteAPI{httpClient: httpClient}
+	c.PhoneCallRedirectRepository = PhoneCallRedirectAPI{httpClient: httpClient}
+	c.SectionRepository = SectionAPI{httpClient: httpClient}
+	c.SegmentRepository = SegmentAPI{httpClient: httpClient}
+	c.SubscriptionTypeRepository = SubscriptionTypeAPI{httpClient: httpClient}
+	c.SubscriptionRepository = SubscriptionAPI{httpClient: httpClient}
+	c.TagRepository = TagAPI{httpClient: httpClient}
+	c.TeamRepository = TeamAPI{httpClient: httpClient}
+	c.TicketTypeRepository = TicketTypeAPI{httpClient: httpClient}
+	c.TicketRepository = TicketAPI{httpClient: httpClient}
+	c.UserRepository = UserAPI{httpClient: httpClient}
 	c.Admins = AdminService{Repository: c.AdminRepository}
+	c.Articles = ArticleService{Repository: c.ArticleRepository}
+	c.Collections = CollectionService{Repository: c.CollectionRepository}
 	c.Companies = CompanyService{Repository: c.CompanyRepository}
 	c.Contacts = ContactService{Repository: c.ContactRepository}
+	c.Counts = CountService{Repository: c.CountRepository}
 	c.Conversations = ConversationService{Repository: c.ConversationRepository}
-	c.Events = EventService{Repository: c.EventRepository}
-	c.Jobs = JobService{Repository: c.JobRepository}
-	c.Messages = MessageService{Repository: c.MessageRepository}
-	c.Segments = SegmentService{Repository: c.SegmentRepository}
-	c.Tags = TagService{Repository: c.TagRepository}
+	c.DataAttributes = DataAttributeService{Repository: c.DataAttributeRepository}
+	c.Events = EventService{Repository: c.EventRepository, JobRepository: c.JobRepository, SkipMetadataValidation: c.Events.SkipMetadataValidation, clock: clock}
+	c.HelpCenters = HelpCenterService{Repository: c.HelpCenterRepository}
+	c.Exports = ExportService{Repository: c.ExportRepository}
+	c.Jobs = JobService{Repository: c.JobRepository, clock: clock}
+	c.Messages = MessageService{Repository: c.MessageRepository, ConversationRepository: c.ConversationRepository, clock: clock}
+	c.NewsItems = NewsItemService{Repository: c.NewsItemRepository}
+	c.Notes = NoteService{Repository: c.NoteRepository}
+	c.PhoneCallRedirects = PhoneCallRedirectService{Repository: c.PhoneCallRedirectRepository}
+	c.Sections = SectionService{Repository: c.SectionRepository}
+	c.Segments = SegmentService{Repository: c.SegmentRepository, UserRepository: c.UserRepository}
+	c.SubscriptionTypes = SubscriptionTypeService{Repository: c.SubscriptionTypeRepository}
+	c.Subscriptions = SubscriptionService{Repository: c.SubscriptionRepository}
+	c.Tags = TagService{Repository: c.TagRepository, clock: clock}
+	c.Teams = TeamService{Repository: c.TeamRepository, AdminRepository: c.AdminRepository}
+	c.TicketTypes = TicketTypeService{Repository: c.TicketTypeRepository}
+	c.Tickets = TicketService{Repository: c.TicketRepository}
 	c.Users = UserService{Repository: c.UserRepository}
 }
+
+// setupWithoutSettings sets up a Client made without NewClient again, after its HTTPClient or Clock changed.
+// The services of one made with NewClient read them under the settings lock already.
+func (c *Client) setupWithoutSettings() {
+	if c.settings == nil {
+		c.setup()
+	}
+}
+
+// settingsHTTPClient is the HTTPClient of a Client's services, making each request with the Client's
+// HTTPClient as it is when the request starts, read under the settings lock.
+type settingsHTTPClient struct {
+	client   *interfaces.HTTPClient
+	settings *sync.RWMutex
+}
+
+func (c settingsHTTPClient) current() interfaces.HTTPClient {
+	if c.settings != nil {
+		c.settings.RLock()
+		defer c.settings.RUnlock()
+	}
+	return *c.client
+}
+
+func (c settingsHTTPClient) Get(url string, queryParams interface{}) ([]byte, error) {
+	return c.current().Get(url, queryParams)
+}
+
+func (c settingsHTTPClient) Post(url string, body interface{}) ([]byte, error) {
+	return c.current().Post(url, body)
+}
+
+func (c settingsHTTPClient) Patch(url string, body interface{}) ([]byte, error) {
+	return c.current().Patch(url, body)
+}
+
+func (c settingsHTTPClient) Delete(url string, queryParams interface{}) ([]byte, error) {
+	return c.current().Delete(url, queryParams)
+}
+
+// currentHTTPClient returns the HTTPClient requests are made with now, to check what else it supports.
+func currentHTTPClient(httpClient interfaces.HTTPClient) interfaces.HTTPClient {
+	if settingsClient, ok := httpClient.(settingsHTTPClient); ok {
+		return settingsClient.current()
+	}
+	return httpClient
+}
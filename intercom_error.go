@@ -1,5 +1,37 @@
 package intercom
 
+import "gopkg.in/intercom/intercom-go.v2/interfaces"
+
+// Errors which errors from the API match with errors.Is, by their status code.
+var (
+	ErrUnauthorized = interfaces.ErrUnauthorized
+	ErrForbidden    = interfaces.ErrForbidden
+	ErrNotFound     = interfaces.ErrNotFound
+	ErrRateLimited  = interfaces.ErrRateLimited
+)
+
+// ErrTimeout is matched by errors from requests which timed out with errors.Is.
+var ErrTimeout = interfaces.ErrTimeout
+
+// ValidationError is an IntercomError for a request rejected as invalid, with a 400 or 422.
+// Field is the parameter at fault, when Intercom says which. Get it with errors.As:
+//
+//	var invalid intercom.ValidationError
+//	if errors.As(err, &invalid) {
+//		fmt.Println(invalid.Field, invalid.Message)
+//	}
+type ValidationError = interfaces.ValidationError
+
+// ErrorDetail is one of the errors in an error response, which IntercomErrors from the API have all of:
+//
+//	var httpError interfaces.HTTPError
+//	if errors.As(err, &httpError) {
+//		for _, detail := range httpError.Errors() {
+//			fmt.Println(detail.Field, detail.Message)
+//		}
+//	}
+type ErrorDetail = interfaces.ErrorDetail
+
 // IntercomError is a known error from the Intercom API
 type IntercomError interface {
 	Error() string
@@ -7,3 +39,16 @@ type IntercomError interface {
 	GetCode() string
 	GetMessage() string
 }
+
+// RateLimitError is the IntercomError returned for a request which was rate limited, with a 429.
+// It has the rate limit from the response, and its Retry-After header. Get it with errors.As:
+//
+//	var limited intercom.RateLimitError
+//	if errors.As(err, &limited) {
+//		delay, _ := limited.RetryDelay(time.Now())
+//	}
+type RateLimitError = interfaces.RateLimitError
+
+// TimeoutError is returned for a request which timed out, including any retries.
+// It matches ErrTimeout with errors.Is.
+type TimeoutError = interfaces.TimeoutError
@@ -0,0 +1,29 @@
+package intercom
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+func TestIntercomErrorIs(t *testing.T) {
+	phoneCallRedirectService := PhoneCallRedirectService{Repository: TestPhoneCallRedirectAPI{
+		err: interfaces.HTTPError{StatusCode: 401, Code: "unauthorized", Message: "Access Token Invalid"},
+	}}
+	_, err := phoneCallRedirectService.Create("+353832345678")
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("Expected ErrUnauthorized, got %v", err)
+	}
+	if herr, ok := err.(IntercomError); !ok || herr.GetCode() != "unauthorized" {
+		t.Errorf("Expected an IntercomError, got %v", err)
+	}
+}
+
+func TestIntercomErrorAsValidationError(t *testing.T) {
+	var err error = interfaces.HTTPError{StatusCode: 422, Code: "parameter_invalid", Message: "Name is invalid", Field: "name"}
+	var invalid ValidationError
+	if !errors.As(err, &invalid) || invalid.Field != "name" {
+		t.Errorf("Expected a ValidationError for name, got %+v", invalid)
+	}
+}
@@ -0,0 +1,310 @@
+package intercom
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+func TestClientWithContext(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	ic.Events.SkipMetadataValidation = true
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := ic.WithContext(ctx)
+	if client == ic {
+		t.Fatalf("Expected a copy of the Client")
+	}
+	if !client.Events.SkipMetadataValidation {
+		t.Errorf("Expected Event options to be kept")
+	}
+	if _, err := client.Admins.List(); err == nil {
+		t.Errorf("Expected a request with a cancelled context to fail")
+	}
+}
+
+func TestClientWithContextCustomHTTPClient(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	ic.Option(SetHTTPClient(TestHTTPClient{}))
+	client := ic.WithContext(context.Background())
+	if client.HTTPClient != (TestHTTPClient{}) {
+		t.Errorf("Expected the HTTPClient to be kept, was %#v", client.HTTPClient)
+	}
+}
+
+func TestRetryRequestsOption(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	previous := ic.Option(RetryRequests(interfaces.RetryOptions{MaxAttempts: 3}))
+	if retry := ic.HTTPClient.(interfaces.IntercomHTTPClient).Retry; retry.MaxAttempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", retry.MaxAttempts)
+	}
+	ic.Option(previous)
+	if ic.retry.MaxAttempts != 0 {
+		t.Errorf("Expected retrying to be off again")
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	if limit := ic.RateLimit(); limit != (interfaces.RateLimitInfo{}) {
+		t.Errorf("Expected no rate limit, got %+v", limit)
+	}
+	ic.Option(SetHTTPClient(TestHTTPClient{}))
+	if limit := ic.RateLimit(); limit != (interfaces.RateLimitInfo{}) {
+		t.Errorf("Expected no rate limit, got %+v", limit)
+	}
+}
+
+func TestThrottleRequestsOption(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	previous := ic.Option(ThrottleRequests(300, 10))
+	if rate, burst := ic.HTTPClient.(interfaces.IntercomHTTPClient).Throttle.Rate(); rate != 300 || burst != 10 {
+		t.Errorf("Throttle rate was %d, %d", rate, burst)
+	}
+	ic.Option(previous)
+	if rate, _ := ic.throttle.Rate(); rate != 0 {
+		t.Errorf("Expected throttling to be off again")
+	}
+}
+
+type testRoundTripper struct {
+	requests int
+}
+
+func (rt *testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.requests++
+	return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{"type":"admin.list","admins":[]}`)), Header: http.Header{}}, nil
+}
+
+func TestSetTransport(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	client := ic.HTTPClient.(interfaces.IntercomHTTPClient).Client
+	rt := &testRoundTripper{}
+	previous := ic.Option(SetTransport(rt))
+	if _, err := ic.Admins.List(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if rt.requests != 1 {
+		t.Errorf("Expected the request to go through the transport")
+	}
+	if client.Transport != nil {
+		t.Errorf("Expected the previous http.Client to be left alone")
+	}
+	ic.Option(previous)
+	if ic.HTTPClient.(interfaces.IntercomHTTPClient).Client.Transport != nil {
+		t.Errorf("Expected the default transport again")
+	}
+}
+
+func TestSetNetHTTPClient(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	rt := &testRoundTripper{}
+	client := &http.Client{Transport: rt, Timeout: time.Second}
+	ic.Option(SetNetHTTPClient(client))
+	ic.Admins.List()
+	if rt.requests != 1 {
+		t.Errorf("Expected the request to go through the client")
+	}
+	if ic.HTTPClient.(interfaces.IntercomHTTPClient).Client.Timeout != time.Second {
+		t.Errorf("Expected the client's timeout to be kept")
+	}
+}
+
+func TestSetAPIVersion(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	previous := ic.Option(SetAPIVersion(APIVersion2_11))
+	if version := *ic.HTTPClient.(interfaces.IntercomHTTPClient).APIVersion; version != "2.11" {
+		t.Errorf("API version was %s", version)
+	}
+	ic.Option(previous)
+	if ic.apiVersion != "" {
+		t.Errorf("Expected no API version again")
+	}
+}
+
+func TestNewOAuthClient(t *testing.T) {
+	ic := NewOAuthClient("token")
+	httpClient := ic.HTTPClient.(interfaces.IntercomHTTPClient)
+	if token := httpClient.AccessToken.Get(); token != "token" {
+		t.Errorf("Access token was %s", token)
+	}
+	ic.SetAccessToken("rotated")
+	if token := httpClient.AccessToken.Get(); token != "rotated" {
+		t.Errorf("Access token was %s", token)
+	}
+}
+
+func TestHookOptions(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	rt := &testRoundTripper{}
+	var paths []string
+	ic.Option(SetTransport(rt), OnRequest(func(req *http.Request) {
+		paths = append(paths, req.URL.Path)
+	}))
+	previous := ic.Option(OnResponse(func(req *http.Request, resp *http.Response, took time.Duration, err error) {
+		paths = append(paths, resp.Status)
+	}))
+	ic.Admins.List()
+	if len(paths) != 2 || paths[0] != "/admins" {
+		t.Errorf("Hooks were called with %v", paths)
+	}
+	ic.Option(previous)
+	if ic.hooks.OnResponse != nil {
+		t.Errorf("Expected the OnResponse hook to be removed")
+	}
+}
+
+func TestClientWithTimeout(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	ic.Option(RequestTimeout(2 * time.Second))
+	if timeout := *ic.HTTPClient.(interfaces.IntercomHTTPClient).Timeout; timeout != 2*time.Second {
+		t.Errorf("Timeout was %s", timeout)
+	}
+	client := ic.WithTimeout(time.Minute)
+	if client == ic {
+		t.Fatalf("Expected a copy of the Client")
+	}
+	if _, ok := client.HTTPClient.(interfaces.IntercomHTTPClient); !ok {
+		t.Errorf("Expected an IntercomHTTPClient, got %T", client.HTTPClient)
+	}
+}
+
+func TestSetRegion(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	previous := ic.Option(SetRegion(RegionEU))
+	if baseURI := *ic.HTTPClient.(interfaces.IntercomHTTPClient).BaseURI; baseURI != "https://api.eu.intercom.io" {
+		t.Errorf("Base URI was %s", baseURI)
+	}
+	ic.Option(SetRegion(RegionAU))
+	if ic.baseURI != "https://api.au.intercom.io" {
+		t.Errorf("Base URI was %s", ic.baseURI)
+	}
+	ic.Option(previous)
+	if ic.baseURI != "https://api.intercom.io" {
+		t.Errorf("Base URI was %s", ic.baseURI)
+	}
+	if RegionUS.BaseURI() != "https://api.intercom.io" {
+		t.Errorf("US base URI was %s", RegionUS.BaseURI())
+	}
+}
+
+func TestNewClientOptions(t *testing.T) {
+	ic := NewClient("app_id", "api_key", BaseURI("http://intercom.dev"), SetAPIVersion(APIVersion2_11))
+	if ic.baseURI != "http://intercom.dev" || ic.apiVersion != APIVersion2_11 {
+		t.Errorf("Expected options to be set, got %s and %s", ic.baseURI, ic.apiVersion)
+	}
+	ic = NewOAuthClient("token", SetRegion(RegionEU))
+	if ic.baseURI != RegionEU.BaseURI() {
+		t.Errorf("Expected options to be set, got %s", ic.baseURI)
+	}
+}
+
+// TestOptionsWhileRequesting is most useful with the race detector: go test -race
+func TestOptionsWhileRequesting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type": "admin.list", "admins": []}`))
+	}))
+	defer server.Close()
+	ic := NewClient("app_id", "api_key", BaseURI(server.URL))
+	httpClient := ic.HTTPClient
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, err := ic.Admins.List(); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				ic.WithTimeout(time.Second).Admins.List()
+				ic.Stats()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				ic.Option(BaseURI(server.URL), SetAPIVersion(APIVersion2_11), TraceHTTP(false), RequestTimeout(time.Minute),
+					RetryRequests(interfaces.RetryOptions{MaxAttempts: 2}), OnRequest(func(*http.Request) {}))
+				ic.Option(SetClock(interfaces.RealClock{}), SetTransport(http.DefaultTransport), SetNetHTTPClient(&http.Client{}),
+					SetHTTPClient(httpClient))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestUserAgentOptions(t *testing.T) {
+	var mu sync.Mutex
+	userAgents := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		attempt := len(userAgents)
+		mu.Unlock()
+		if attempt == 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		w.Write([]byte(`{"type": "admin.list", "admins": []}`))
+	}))
+	defer server.Close()
+
+	ic := NewClient("app_id", "api_key", BaseURI(server.URL), RetryRequests(interfaces.RetryOptions{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	ic.Admins.List()
+	previous := ic.Option(AppendUserAgent("my-service/1.2"))
+	ic.Admins.List()
+	ic.Option(previous, SetUserAgent("my-service"))
+	ic.Admins.List()
+
+	expected := []string{"intercom-go/" + clientVersion, "intercom-go/" + clientVersion + " my-service/1.2", "intercom-go/" + clientVersion + " my-service/1.2", "my-service"}
+	if strings.Join(userAgents, ",") != strings.Join(expected, ",") {
+		t.Errorf("User-Agents were %q, expected %q", userAgents, expected)
+	}
+}
+
+func TestClientStats(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	ic.Option(SetTransport(&testRoundTripper{}))
+	ic.WithContext(context.Background()).Admins.List()
+	if stats := ic.Stats(); stats.Requests != 1 || stats.InFlight != 0 {
+		t.Errorf("Stats were %+v, expected the copy's request to be counted", stats)
+	}
+	ic.Option(SetHTTPClient(TestHTTPClient{}))
+	if stats := ic.Stats(); stats != (interfaces.Stats{}) {
+		t.Errorf("Stats were %+v, expected none", stats)
+	}
+}
+
+func TestDefaultHeadersOption(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	header := http.Header{"X-Egress-Token": {"secret"}}
+	previous := ic.Option(DefaultHeaders(header))
+	header.Set("X-Egress-Token", "changed")
+	if token := ic.headers.Get("X-Egress-Token"); token != "secret" {
+		t.Errorf("Expected the headers to be copied, got %s", token)
+	}
+	ic.Option(previous)
+	if ic.headers != nil {
+		t.Errorf("Expected the headers to be removed, got %v", ic.headers)
+	}
+}
+
+func TestSetRetryPolicy(t *testing.T) {
+	ic := NewClient("app_id", "api_key")
+	previous := ic.Option(SetRetryPolicy(interfaces.DefaultRetryOptions()))
+	if policy := *ic.HTTPClient.(interfaces.IntercomHTTPClient).RetryPolicy; policy != interfaces.RetryPolicy(interfaces.DefaultRetryOptions()) {
+		t.Errorf("Retry policy was %#v", policy)
+	}
+	ic.Option(previous)
+	if ic.retryPolicy != nil {
+		t.Errorf("Expected the retry policy to be removed")
+	}
+}
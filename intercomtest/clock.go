@@ -0,0 +1,59 @@
+package intercomtest
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is an interfaces.Clock for intercom.SetClock whose time only moves when it's waited on,
+// or advanced: waiting moves it on by the time waited straight away, so backoff happens instantly,
+// and each wait is recorded, so the sequence can be checked:
+//
+//	clock := intercomtest.NewClock(time.Unix(1500000000, 0))
+//	ic := intercom.NewClient("appID", "apiKey", intercom.SetClock(clock), intercom.RetryRequests(retryOptions))
+//	// ... make requests which are retried ...
+//	clock.Waits() // the delays before each retry
+//
+// It is safe for concurrent use.
+type Clock struct {
+	mu    sync.Mutex
+	now   time.Time
+	waits []time.Duration
+}
+
+// NewClock returns a Clock whose time starts at now.
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// Now returns the Clock's time.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After moves the Clock on by d, recording the wait, and returns a channel which has the new time.
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.waits = append(c.waits, d)
+	c.now = c.now.Add(d)
+	after := make(chan time.Time, 1)
+	after <- c.now
+	return after
+}
+
+// Advance moves the Clock on by d, without it counting as a wait.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Waits returns how long each wait on the Clock was, in order.
+func (c *Clock) Waits() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]time.Duration{}, c.waits...)
+}
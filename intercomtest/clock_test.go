@@ -0,0 +1,33 @@
+package intercomtest
+
+import (
+	"testing"
+	"time"
+
+	intercom "gopkg.in/intercom/intercom-go.v2"
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+func TestClockRetries(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Handle("GET", "/users", RateLimitHandler(30))
+	clock := NewClock(time.Unix(1500000000, 0))
+	ic := intercom.NewClient("intercomtest", "intercomtest", intercom.BaseURI(server.URL), intercom.SetClock(clock),
+		intercom.RetryRequests(interfaces.RetryOptions{MaxAttempts: 4, MaxDelay: time.Minute}))
+
+	if _, err := ic.Users.List(intercom.PageParams{}); err == nil {
+		t.Errorf("Expected the last rate limit error")
+	}
+	waits := clock.Waits()
+	if len(waits) != 3 || waits[0] != 30*time.Second || waits[2] != 30*time.Second {
+		t.Errorf("Waits were %v", waits)
+	}
+	if now := clock.Now(); !now.Equal(time.Unix(1500000090, 0)) {
+		t.Errorf("Clock was at %s", now)
+	}
+	clock.Advance(time.Hour)
+	if len(clock.Waits()) != 3 {
+		t.Errorf("Expected advancing not to count as a wait")
+	}
+}
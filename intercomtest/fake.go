@@ -0,0 +1,291 @@
+// Package intercomtest provides an in-memory fake of the Intercom API, for testing code which uses intercom-go.
+//
+// The Fake is a HTTPClient, so the real services are exercised against it:
+//
+//	fake := intercomtest.New()
+//	fake.AddUsers(intercom.User{UserID: "27", Email: "bob@example.io"})
+//	ic := fake.Client()
+//	// ... run the code under test with ic ...
+//	fake.Replies() // the replies it sent
+//
+// It supports Users, Conversations and Tags, pages lists, and can be told to fail requests,
+// for example with a 429, to test error handling.
+//
+// The Server instead answers real HTTP requests with the JSON fixtures intercom-go's own tests decode.
+package intercomtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/go-querystring/query"
+
+	intercom "gopkg.in/intercom/intercom-go.v2"
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// DefaultPerPage is the page size of lists, when a request doesn't ask for one.
+const DefaultPerPage = 50
+
+// A Request is a request made to the Fake.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   []byte
+}
+
+// A RecordedReply is a Reply sent to a Conversation.
+type RecordedReply struct {
+	ConversationID string
+	Reply          intercom.Reply
+}
+
+// Fake is an in-memory fake of the Intercom API, which implements interfaces.HTTPClient.
+// It is safe for concurrent use.
+type Fake struct {
+	mu            sync.Mutex
+	users         []intercom.User
+	conversations []intercom.Conversation
+	tags          []intercom.Tag
+	requests      []Request
+	replies       []RecordedReply
+	taggings      []intercom.TaggingList
+	failures      []error
+	lastID        int
+}
+
+// New returns an empty Fake.
+func New() *Fake {
+	return &Fake{}
+}
+
+// Client returns a new intercom.Client which makes its requests to the Fake.
+func (f *Fake) Client() *intercom.Client {
+	ic := intercom.NewClient("intercomtest", "intercomtest")
+	ic.Option(intercom.SetHTTPClient(f))
+	return ic
+}
+
+// AddUsers adds Users to the Fake, giving an ID to any without one.
+func (f *Fake) AddUsers(users ...intercom.User) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, user := range users {
+		if user.ID == "" {
+			user.ID = f.newID()
+		}
+		f.users = append(f.users, user)
+	}
+}
+
+// AddConversations adds Conversations to the Fake, giving an ID to any without one.
+func (f *Fake) AddConversations(conversations ...intercom.Conversation) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, conversation := range conversations {
+		if conversation.ID == "" {
+			conversation.ID = f.newID()
+		}
+		f.conversations = append(f.conversations, conversation)
+	}
+}
+
+// AddTags adds Tags to the Fake, giving an ID to any without one.
+func (f *Fake) AddTags(tags ...intercom.Tag) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, tag := range tags {
+		if tag.ID == "" {
+			tag.ID = intercom.ID(f.newID())
+		}
+		f.tags = append(f.tags, tag)
+	}
+}
+
+// Users returns the Users in the Fake, including those saved through it.
+func (f *Fake) Users() []intercom.User {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]intercom.User{}, f.users...)
+}
+
+// Conversations returns the Conversations in the Fake, including any replies.
+func (f *Fake) Conversations() []intercom.Conversation {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]intercom.Conversation{}, f.conversations...)
+}
+
+// Tags returns the Tags in the Fake, including those saved through it.
+func (f *Fake) Tags() []intercom.Tag {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]intercom.Tag{}, f.tags...)
+}
+
+// Requests returns the requests made to the Fake, in order, including those which failed.
+func (f *Fake) Requests() []Request {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Request{}, f.requests...)
+}
+
+// Replies returns the replies sent to Conversations, in order.
+func (f *Fake) Replies() []RecordedReply {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]RecordedReply{}, f.replies...)
+}
+
+// Taggings returns the requests to tag, or untag, Users and Companies, in order.
+func (f *Fake) Taggings() []intercom.TaggingList {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]intercom.TaggingList{}, f.taggings...)
+}
+
+// FailNext makes the next requests fail with errs, one each, in order.
+func (f *Fake) FailNext(errs ...error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = append(f.failures, errs...)
+}
+
+// RateLimitNext makes the next n requests fail as rate limited, with a 429.
+func (f *Fake) RateLimitNext(n int) {
+	for i := 0; i < n; i++ {
+		f.FailNext(interfaces.RateLimitError{
+			HTTPError:  interfaces.HTTPError{StatusCode: http.StatusTooManyRequests, Code: "rate_limit_exceeded", Message: "Rate Limit Exceeded"},
+			RetryAfter: "0",
+		})
+	}
+}
+
+// Get implements interfaces.HTTPClient.
+func (f *Fake) Get(uri string, queryParams interface{}) ([]byte, error) {
+	return f.do("GET", uri, queryParams, nil)
+}
+
+// Post implements interfaces.HTTPClient.
+func (f *Fake) Post(uri string, body interface{}) ([]byte, error) {
+	return f.do("POST", uri, nil, body)
+}
+
+// Patch implements interfaces.HTTPClient.
+func (f *Fake) Patch(uri string, body interface{}) ([]byte, error) {
+	return f.do("PATCH", uri, nil, body)
+}
+
+// Put implements interfaces.HTTPPutClient.
+func (f *Fake) Put(uri string, body interface{}) ([]byte, error) {
+	return f.do("PUT", uri, nil, body)
+}
+
+// Delete implements interfaces.HTTPClient.
+func (f *Fake) Delete(uri string, queryParams interface{}) ([]byte, error) {
+	return f.do("DELETE", uri, queryParams, nil)
+}
+
+// DeleteWithBody implements interfaces.HTTPDeleteBodyClient.
+func (f *Fake) DeleteWithBody(uri string, body interface{}) ([]byte, error) {
+	return f.do("DELETE", uri, nil, body)
+}
+
+func (f *Fake) do(method, uri string, queryParams, body interface{}) ([]byte, error) {
+	req := Request{Method: method, Path: uri, Query: url.Values{}}
+	if queryParams != nil {
+		if values, err := query.Values(queryParams); err == nil {
+			req.Query = values
+		}
+	}
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = data
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, req)
+	if len(f.failures) > 0 {
+		err := f.failures[0]
+		f.failures = f.failures[1:]
+		return nil, err
+	}
+	response, err := f.route(req)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(response)
+}
+
+func (f *Fake) route(req Request) (interface{}, error) {
+	path := strings.Split(strings.Trim(req.Path, "/"), "/")
+	switch {
+	case req.Method == "GET" && req.Path == "/users":
+		return f.getUsers(req.Query)
+	case req.Method == "GET" && len(path) == 2 && path[0] == "users":
+		return f.findUser(func(u intercom.User) bool { return u.ID == path[1] })
+	case req.Method == "POST" && req.Path == "/users":
+		return f.saveUser(req.Body)
+	case req.Method == "DELETE" && len(path) == 2 && path[0] == "users":
+		return f.deleteUser(path[1])
+	case req.Method == "GET" && req.Path == "/conversations":
+		return f.listConversations(req.Query)
+	case req.Method == "GET" && len(path) == 2 && path[0] == "conversations":
+		return f.findConversation(path[1])
+	case req.Method == "POST" && len(path) == 2 && path[0] == "conversations":
+		return f.readConversation(path[1])
+	case req.Method == "POST" && len(path) == 3 && path[0] == "conversations" && path[2] == "reply":
+		return f.replyToConversation(path[1], req.Body)
+	case req.Method == "GET" && req.Path == "/tags":
+		return intercom.TagList{Tags: f.tags}, nil
+	case req.Method == "POST" && req.Path == "/tags":
+		return f.saveTag(req.Body)
+	case req.Method == "DELETE" && len(path) == 2 && path[0] == "tags":
+		return f.deleteTag(path[1])
+	}
+	return nil, fmt.Errorf("intercomtest: %s %s is not supported", req.Method, req.Path)
+}
+
+func (f *Fake) newID() string {
+	f.lastID++
+	return strconv.Itoa(f.lastID)
+}
+
+func notFound(resource string) error {
+	return interfaces.HTTPError{StatusCode: http.StatusNotFound, Code: "not_found", Message: resource + " Not Found"}
+}
+
+func invalid(message string) error {
+	return interfaces.HTTPError{StatusCode: http.StatusBadRequest, Code: "parameter_invalid", Message: message}
+}
+
+// page returns the bounds of the requested page of a list of n items, with its paging information.
+func page(q url.Values, n int) (int, int, intercom.PageParams) {
+	pageNumber, _ := strconv.Atoi(q.Get("page"))
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	perPage, _ := strconv.Atoi(q.Get("per_page"))
+	if perPage < 1 {
+		perPage = DefaultPerPage
+	}
+	totalPages := (n + perPage - 1) / perPage
+	start, end := (pageNumber-1)*perPage, pageNumber*perPage
+	if start > n {
+		start = n
+	}
+	if end > n {
+		end = n
+	}
+	return start, end, intercom.PageParams{Page: int64(pageNumber), PerPage: int64(perPage), TotalPages: int64(totalPages)}
+}
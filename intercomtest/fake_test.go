@@ -0,0 +1,118 @@
+package intercomtest
+
+import (
+	"errors"
+	"testing"
+
+	intercom "gopkg.in/intercom/intercom-go.v2"
+)
+
+func TestFakeUsers(t *testing.T) {
+	fake := New()
+	fake.AddUsers(intercom.User{UserID: "27", Email: "bob@example.io"}, intercom.User{UserID: "28"}, intercom.User{UserID: "29"})
+	ic := fake.Client()
+
+	user, err := ic.Users.FindByEmail("bob@example.io")
+	if err != nil || user.UserID != "27" {
+		t.Fatalf("Found %v, %v", user, err)
+	}
+	user.Name = "Bob"
+	if _, err := ic.Users.Save(&user); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fake.Users()[0].Name != "Bob" {
+		t.Errorf("Expected the User to be updated, got %v", fake.Users()[0])
+	}
+	if _, err := ic.Users.Save(&intercom.User{Email: "alice@example.io"}); err != nil || len(fake.Users()) != 4 {
+		t.Errorf("Expected a new User, got %v", err)
+	}
+
+	userList, _ := ic.Users.List(intercom.PageParams{Page: 2, PerPage: 3})
+	if len(userList.Users) != 1 || userList.Pages.TotalPages != 2 || userList.Users[0].Email != "alice@example.io" {
+		t.Errorf("Second page was %+v", userList)
+	}
+
+	users := ic.Users.All(intercom.PageParams{PerPage: 3})
+	count := 0
+	for users.Next() {
+		count++
+	}
+	if users.Err() != nil || count != 4 {
+		t.Errorf("Paged through %d Users, expected 4: %v", count, users.Err())
+	}
+
+	_, err = ic.Users.FindByUserID("404")
+	if !errors.Is(err, intercom.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestFakeConversationReplies(t *testing.T) {
+	fake := New()
+	fake.AddConversations(intercom.Conversation{ID: "123", Open: true})
+	ic := fake.Client()
+
+	admin := intercom.Admin{ID: "5"}
+	if _, err := ic.Conversations.Reply("123", &admin, intercom.CONVERSATION_COMMENT, "Hello"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	conversation, err := ic.Conversations.Close("123", &admin)
+	if err != nil || conversation.Open {
+		t.Errorf("Expected the Conversation to be closed, got %v", err)
+	}
+	replies := fake.Replies()
+	if len(replies) != 2 || replies[0].ConversationID != "123" || replies[0].Reply.Body != "Hello" || replies[0].Reply.AdminID != "5" {
+		t.Errorf("Replies were %+v", replies)
+	}
+	if parts := fake.Conversations()[0].ConversationParts.Parts; len(parts) != 2 {
+		t.Errorf("Conversation parts were %+v", parts)
+	}
+}
+
+func TestFakeTagging(t *testing.T) {
+	fake := New()
+	fake.AddUsers(intercom.User{UserID: "27"}, intercom.User{UserID: "28"})
+	ic := fake.Client()
+
+	tag, err := ic.Tags.Tag(&intercom.TaggingList{Name: "VIP", Users: []intercom.Tagging{{UserID: "27"}}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if taggings := fake.Taggings(); len(taggings) != 1 || taggings[0].Users[0].UserID != "27" {
+		t.Errorf("Taggings were %+v", taggings)
+	}
+	userList, _ := ic.Users.ListByTag(tag.ID.String(), intercom.PageParams{})
+	if len(userList.Users) != 1 || userList.Users[0].UserID != "27" {
+		t.Errorf("Tagged Users were %+v", userList.Users)
+	}
+	if _, err := ic.Tags.UntagUsers("VIP", []string{"27"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if userList, _ = ic.Users.ListByTag(tag.ID.String(), intercom.PageParams{}); len(userList.Users) != 0 {
+		t.Errorf("Expected no tagged Users, got %+v", userList.Users)
+	}
+}
+
+func TestFakeFailures(t *testing.T) {
+	fake := New()
+	fake.AddTags(intercom.Tag{Name: "VIP"})
+	ic := fake.Client()
+
+	fake.RateLimitNext(2)
+	for i := 0; i < 2; i++ {
+		var limited intercom.RateLimitError
+		if _, err := ic.Tags.List(); !errors.As(err, &limited) {
+			t.Errorf("Expected a RateLimitError, got %v", err)
+		}
+	}
+	if tagList, err := ic.Tags.List(); err != nil || len(tagList.Tags) != 1 {
+		t.Errorf("Got %+v, %v", tagList, err)
+	}
+	if requests := fake.Requests(); len(requests) != 3 || requests[2].Path != "/tags" {
+		t.Errorf("Requests were %+v", requests)
+	}
+
+	if _, err := ic.Admins.List(); err == nil {
+		t.Errorf("Expected an error for an unsupported request")
+	}
+}
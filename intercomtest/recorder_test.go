@@ -0,0 +1,94 @@
+package intercomtest
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	intercom "gopkg.in/intercom/intercom-go.v2"
+)
+
+func TestRecorder(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	server := NewServer()
+	recorder, err := NewRecorder(cassette, ModeRecord)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	recorder.Scrub = ScrubEmails
+	ic := intercom.NewClient("app_id", "api_key", intercom.BaseURI(server.URL), intercom.SetTransport(recorder))
+	if _, err := ic.Users.FindByEmail("bob@example.io"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := ic.Conversations.Find("147"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	server.Close()
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Unexpected error saving: %v", err)
+	}
+
+	data, _ := ioutil.ReadFile(cassette)
+	if strings.Contains(string(data), "Authorization") || strings.Contains(string(data), "bob") || strings.Contains(string(data), "myuser@example.io") {
+		t.Errorf("Expected the auth header and emails to be scrubbed, got %s", data)
+	}
+
+	replayer, err := NewRecorder(cassette, ModeReplay)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	replayer.Scrub = ScrubEmails
+	ic = intercom.NewClient("app_id", "api_key", intercom.BaseURI(server.URL), intercom.SetTransport(replayer))
+	convo, err := ic.Conversations.Find("147")
+	if err != nil || convo.ID != "147" {
+		t.Errorf("Conversation was %+v, %v", convo, err)
+	}
+	user, err := ic.Users.FindByEmail("alice@example.io")
+	if err != nil || user.Email != "scrubbed@example.com" {
+		t.Errorf("User was %+v, %v", user, err)
+	}
+	if _, err := ic.Conversations.Find("147"); err == nil || !strings.Contains(err.Error(), "no recorded response") {
+		t.Errorf("Expected an unmatched request to fail, got %v", err)
+	}
+}
+
+func TestRecorderGzip(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	server := NewServer()
+	server.Handle("GET", "/users", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("Accept-Encoding was %q, expected gzip", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write(Fixture("user.json"))
+		gz.Close()
+	}))
+	recorder, _ := NewRecorder(cassette, ModeRecord)
+	recorder.Scrub = ScrubEmails
+	ic := intercom.NewClient("app_id", "api_key", intercom.BaseURI(server.URL), intercom.SetTransport(recorder))
+	if _, err := ic.Users.FindByEmail("bob@example.io"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	server.Close()
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Unexpected error saving: %v", err)
+	}
+
+	data, _ := ioutil.ReadFile(cassette)
+	if strings.Contains(string(data), "Content-Encoding") || !strings.Contains(string(data), "scrubbed@example.com") {
+		t.Errorf("Expected the body to be recorded decompressed and scrubbed, got %s", data)
+	}
+
+	replayer, _ := NewRecorder(cassette, ModeReplay)
+	replayer.Scrub = ScrubEmails
+	ic = intercom.NewClient("app_id", "api_key", intercom.BaseURI(server.URL), intercom.SetTransport(replayer))
+	user, err := ic.Users.FindByEmail("bob@example.io")
+	if err != nil || user.Email != "scrubbed@example.com" {
+		t.Errorf("User was %+v, %v", user, err)
+	}
+}
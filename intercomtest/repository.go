@@ -0,0 +1,213 @@
+package intercomtest
+
+import (
+	"testing"
+	"time"
+
+	intercom "gopkg.in/intercom/intercom-go.v2"
+)
+
+// RunConversationRepositoryTests checks a ConversationRepository behaves as intercom.ConversationAPI does,
+// so another implementation, for caching or reading from a mirror, can be tested as the reference is.
+// The repository must have a Conversation with the id, which the tests read and reply to:
+//
+//	func TestCachingRepository(t *testing.T) {
+//		intercomtest.RunConversationRepositoryTests(t, NewCachingRepository(ic.ConversationRepository), "147")
+//	}
+func RunConversationRepositoryTests(t *testing.T, repository intercom.ConversationRepository, id string) {
+	t.Helper()
+	t.Run("Find", func(t *testing.T) {
+		convo, err := repository.Find(id)
+		if err != nil {
+			t.Fatalf("Find(%q) failed: %v", id, err)
+		}
+		if convo.ID != id {
+			t.Errorf("Find(%q) returned Conversation %q", id, convo.ID)
+		}
+	})
+	t.Run("List", func(t *testing.T) {
+		convoList, err := repository.List(intercom.ConversationListParams{})
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if len(convoList.Conversations) == 0 {
+			t.Fatalf("List returned no Conversations")
+		}
+		for i, convo := range convoList.Conversations {
+			if convo.ID == "" {
+				t.Errorf("List returned Conversation %d with no ID", i)
+			}
+		}
+		streamRepository, ok := repository.(intercom.ConversationStreamRepository)
+		if !ok {
+			return
+		}
+		streamed := []intercom.Conversation{}
+		pages, err := streamRepository.Stream(intercom.ConversationListParams{}, func(convo intercom.Conversation) error {
+			streamed = append(streamed, convo)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Stream failed: %v", err)
+		}
+		if len(streamed) != len(convoList.Conversations) || pages != convoList.Pages {
+			t.Errorf("Stream returned %d Conversations and %+v, List %d and %+v", len(streamed), pages, len(convoList.Conversations), convoList.Pages)
+		}
+	})
+	t.Run("Read", func(t *testing.T) {
+		convo, err := repository.Read(id)
+		if err != nil {
+			t.Fatalf("Read(%q) failed: %v", id, err)
+		}
+		if convo.ID != id {
+			t.Errorf("Read(%q) returned Conversation %q", id, convo.ID)
+		}
+	})
+	t.Run("Reply", func(t *testing.T) {
+		reply := intercom.Reply{Type: "admin", ReplyType: intercom.CONVERSATION_COMMENT.String(), AdminID: "intercomtest", Body: "intercomtest reply"}
+		convo, err := repository.Reply(id, &reply)
+		if err != nil {
+			t.Fatalf("Reply(%q) failed: %v", id, err)
+		}
+		if convo.ID != id {
+			t.Errorf("Reply(%q) returned Conversation %q", id, convo.ID)
+		}
+	})
+}
+
+// RunUserRepositoryTests checks a UserRepository behaves as intercom.UserAPI does, such as a cache of it.
+// It saves a User, finds them by each identifier, lists and scrolls Users, then deletes the User.
+func RunUserRepositoryTests(t *testing.T, repository intercom.UserRepository) {
+	t.Helper()
+	saved, err := repository.Save(&intercom.User{UserID: "intercomtest", Email: "intercomtest@example.io", Name: "intercomtest"})
+	if err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if saved.ID == "" {
+		t.Fatalf("Save returned a User with no ID")
+	}
+	t.Run("Find", func(t *testing.T) {
+		for _, identifiers := range []intercom.UserIdentifiers{{ID: saved.ID}, {UserID: saved.UserID}, {Email: saved.Email}} {
+			user, err := repository.Find(identifiers)
+			if err != nil {
+				t.Fatalf("Find(%+v) failed: %v", identifiers, err)
+			}
+			if user.ID != saved.ID {
+				t.Errorf("Find(%+v) returned User %q, expected %q", identifiers, user.ID, saved.ID)
+			}
+		}
+	})
+	t.Run("List", func(t *testing.T) {
+		userList, err := repository.List(intercom.UserListParams{})
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if len(userList.Users) == 0 {
+			t.Errorf("List returned no Users")
+		}
+	})
+	t.Run("Scroll", func(t *testing.T) {
+		if _, err := repository.Scroll(""); err != nil {
+			t.Fatalf("Scroll failed: %v", err)
+		}
+	})
+	t.Run("Delete", func(t *testing.T) {
+		if _, err := repository.Delete(saved.ID); err != nil {
+			t.Fatalf("Delete(%q) failed: %v", saved.ID, err)
+		}
+	})
+}
+
+// RunCompanyRepositoryTests checks a CompanyRepository behaves as intercom.CompanyAPI does.
+// It saves a Company, finds it by each identifier, and lists and scrolls Companies.
+func RunCompanyRepositoryTests(t *testing.T, repository intercom.CompanyRepository) {
+	t.Helper()
+	saved, err := repository.Save(&intercom.Company{CompanyID: "intercomtest", Name: "intercomtest"})
+	if err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if saved.ID == "" {
+		t.Fatalf("Save returned a Company with no ID")
+	}
+	t.Run("Find", func(t *testing.T) {
+		for _, identifiers := range []intercom.CompanyIdentifiers{{ID: saved.ID}, {CompanyID: saved.CompanyID}, {Name: saved.Name}} {
+			company, err := repository.Find(identifiers)
+			if err != nil {
+				t.Fatalf("Find(%+v) failed: %v", identifiers, err)
+			}
+			if company.ID != saved.ID {
+				t.Errorf("Find(%+v) returned Company %q, expected %q", identifiers, company.ID, saved.ID)
+			}
+		}
+	})
+	t.Run("List", func(t *testing.T) {
+		companyList, err := repository.List(intercom.CompanyListParams{})
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if len(companyList.Companies) == 0 {
+			t.Errorf("List returned no Companies")
+		}
+	})
+	t.Run("Scroll", func(t *testing.T) {
+		if _, err := repository.Scroll(""); err != nil {
+			t.Fatalf("Scroll failed: %v", err)
+		}
+	})
+}
+
+// RunTagRepositoryTests checks a TagRepository behaves as intercom.TagAPI does.
+// It saves a Tag, lists Tags, tags a User with it, then deletes it.
+func RunTagRepositoryTests(t *testing.T, repository intercom.TagRepository) {
+	t.Helper()
+	saved, err := repository.Save(&intercom.Tag{Name: "intercomtest"})
+	if err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if saved.ID == "" || saved.Name == "" {
+		t.Fatalf("Save returned Tag %+v, expected an ID and Name", saved)
+	}
+	t.Run("List", func(t *testing.T) {
+		tagList, err := repository.List()
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if len(tagList.Tags) == 0 {
+			t.Errorf("List returned no Tags")
+		}
+	})
+	t.Run("Tag", func(t *testing.T) {
+		tag, err := repository.Tag(&intercom.TaggingList{Name: saved.Name, Users: []intercom.Tagging{{UserID: "intercomtest"}}})
+		if err != nil {
+			t.Fatalf("Tag failed: %v", err)
+		}
+		if tag.ID != saved.ID {
+			t.Errorf("Tag returned Tag %q, expected %q", tag.ID, saved.ID)
+		}
+	})
+	t.Run("Delete", func(t *testing.T) {
+		if err := repository.Delete(saved.ID.String()); err != nil {
+			t.Fatalf("Delete(%q) failed: %v", saved.ID, err)
+		}
+	})
+}
+
+// RunEventRepositoryTests checks an EventRepository accepts what intercom.EventAPI does,
+// so one which drops Events, for load tests, passes too. It saves an Event, then lists the User's Events and summaries.
+func RunEventRepositoryTests(t *testing.T, repository intercom.EventRepository) {
+	t.Helper()
+	event := intercom.Event{UserID: "intercomtest", EventName: "intercomtest-event", CreatedAt: time.Now().Unix(), Metadata: map[string]interface{}{"test": true}}
+	if err := repository.Save(&event); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	t.Run("List", func(t *testing.T) {
+		if _, err := repository.List(intercom.EventListParams{Type: "user", UserID: event.UserID}); err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+	})
+	t.Run("Summaries", func(t *testing.T) {
+		if _, err := repository.Summaries(intercom.EventListParams{Type: "user", UserID: event.UserID, Summary: true}); err != nil {
+			t.Fatalf("Summaries failed: %v", err)
+		}
+	})
+}
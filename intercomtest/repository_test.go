@@ -0,0 +1,43 @@
+package intercomtest
+
+import (
+	"testing"
+
+	intercom "gopkg.in/intercom/intercom-go.v2"
+)
+
+func TestConversationAPIRepository(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	RunConversationRepositoryTests(t, server.Client().ConversationRepository, "147")
+}
+
+func TestFakeConversationRepository(t *testing.T) {
+	fake := New()
+	fake.AddConversations(intercom.Conversation{ID: "147", Open: true})
+	RunConversationRepositoryTests(t, fake.Client().ConversationRepository, "147")
+}
+
+func TestUserAPIRepository(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	RunUserRepositoryTests(t, server.Client().UserRepository)
+}
+
+func TestCompanyAPIRepository(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	RunCompanyRepositoryTests(t, server.Client().CompanyRepository)
+}
+
+func TestTagAPIRepository(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	RunTagRepositoryTests(t, server.Client().TagRepository)
+}
+
+func TestEventAPIRepository(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	RunEventRepositoryTests(t, server.Client().EventRepository)
+}
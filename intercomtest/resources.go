@@ -0,0 +1,272 @@
+package intercomtest
+
+import (
+	"encoding/json"
+	"net/url"
+
+	intercom "gopkg.in/intercom/intercom-go.v2"
+)
+
+type userList struct {
+	Type  string              `json:"type"`
+	Pages intercom.PageParams `json:"pages"`
+	Users []intercom.User     `json:"users"`
+}
+
+type conversationList struct {
+	Type          string                  `json:"type"`
+	Pages         intercom.PageParams     `json:"pages"`
+	Conversations []intercom.Conversation `json:"conversations"`
+}
+
+func (f *Fake) getUsers(q url.Values) (interface{}, error) {
+	if userID := q.Get("user_id"); userID != "" {
+		return f.findUser(func(u intercom.User) bool { return u.UserID == userID })
+	}
+	if email := q.Get("email"); email != "" {
+		return f.findUser(func(u intercom.User) bool { return u.Email == email })
+	}
+	users := f.users
+	if tagID := q.Get("tag_id"); tagID != "" {
+		users = nil
+		for _, user := range f.users {
+			if hasTag(user, tagID) {
+				users = append(users, user)
+			}
+		}
+	}
+	start, end, pages := page(q, len(users))
+	return userList{Type: "user.list", Pages: pages, Users: append([]intercom.User{}, users[start:end]...)}, nil
+}
+
+func (f *Fake) userIndex(match func(intercom.User) bool) int {
+	for i, user := range f.users {
+		if match(user) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (f *Fake) findUser(match func(intercom.User) bool) (interface{}, error) {
+	i := f.userIndex(match)
+	if i < 0 {
+		return nil, notFound("User")
+	}
+	return f.users[i], nil
+}
+
+// saveUser creates or updates a User, matched by ID, UserID, then Email, as Intercom does.
+func (f *Fake) saveUser(body []byte) (interface{}, error) {
+	saved := intercom.User{}
+	if err := json.Unmarshal(body, &saved); err != nil {
+		return nil, err
+	}
+	i := -1
+	switch {
+	case saved.ID != "":
+		i = f.userIndex(func(u intercom.User) bool { return u.ID == saved.ID })
+	case saved.UserID != "":
+		i = f.userIndex(func(u intercom.User) bool { return u.UserID == saved.UserID })
+	case saved.Email != "":
+		i = f.userIndex(func(u intercom.User) bool { return u.Email == saved.Email })
+	default:
+		return nil, invalid("Missing User Identifier")
+	}
+	if i < 0 {
+		if saved.ID != "" {
+			return nil, notFound("User")
+		}
+		saved.ID = f.newID()
+		f.users = append(f.users, saved)
+		return saved, nil
+	}
+	user := &f.users[i]
+	if saved.Email != "" {
+		user.Email = saved.Email
+	}
+	if saved.UserID != "" {
+		user.UserID = saved.UserID
+	}
+	if saved.Name != "" {
+		user.Name = saved.Name
+	}
+	if saved.Phone != "" {
+		user.Phone = saved.Phone
+	}
+	if saved.SignedUpAt != 0 {
+		user.SignedUpAt = saved.SignedUpAt
+	}
+	if saved.UnsubscribedFromEmails != nil {
+		user.UnsubscribedFromEmails = saved.UnsubscribedFromEmails
+	}
+	for key, value := range saved.CustomAttributes {
+		if user.CustomAttributes == nil {
+			user.CustomAttributes = map[string]interface{}{}
+		}
+		user.CustomAttributes[key] = value
+	}
+	return *user, nil
+}
+
+func (f *Fake) deleteUser(id string) (interface{}, error) {
+	i := f.userIndex(func(u intercom.User) bool { return u.ID == id })
+	if i < 0 {
+		return nil, notFound("User")
+	}
+	user := f.users[i]
+	f.users = append(f.users[:i], f.users[i+1:]...)
+	return user, nil
+}
+
+func (f *Fake) conversationIndex(id string) int {
+	for i, conversation := range f.conversations {
+		if conversation.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (f *Fake) listConversations(q url.Values) (interface{}, error) {
+	conversations := []intercom.Conversation{}
+	for _, conversation := range f.conversations {
+		switch {
+		case q.Get("open") == "true" && !conversation.Open:
+		case q.Get("open") == "false" && conversation.Open:
+		case q.Get("user_id") != "" && conversation.User.UserID != q.Get("user_id"):
+		case q.Get("intercom_user_id") != "" && conversation.User.ID != q.Get("intercom_user_id"):
+		case q.Get("email") != "" && conversation.User.Email != q.Get("email"):
+		case q.Get("admin_id") != "" && conversation.Assignee.ID.String() != q.Get("admin_id"):
+		default:
+			conversations = append(conversations, conversation)
+		}
+	}
+	start, end, pages := page(q, len(conversations))
+	return conversationList{Type: "conversation.list", Pages: pages, Conversations: conversations[start:end]}, nil
+}
+
+func (f *Fake) findConversation(id string) (interface{}, error) {
+	i := f.conversationIndex(id)
+	if i < 0 {
+		return nil, notFound("Conversation")
+	}
+	return f.conversations[i], nil
+}
+
+func (f *Fake) readConversation(id string) (interface{}, error) {
+	i := f.conversationIndex(id)
+	if i < 0 {
+		return nil, notFound("Conversation")
+	}
+	f.conversations[i].Read = true
+	return f.conversations[i], nil
+}
+
+func (f *Fake) replyToConversation(id string, body []byte) (interface{}, error) {
+	i := f.conversationIndex(id)
+	if i < 0 {
+		return nil, notFound("Conversation")
+	}
+	reply := intercom.Reply{}
+	if err := json.Unmarshal(body, &reply); err != nil {
+		return nil, err
+	}
+	f.replies = append(f.replies, RecordedReply{ConversationID: id, Reply: reply})
+
+	conversation := &f.conversations[i]
+	part := intercom.ConversationPart{ID: f.newID(), PartType: reply.ReplyType, Body: reply.Body}
+	switch reply.Type {
+	case "admin":
+		part.Author = intercom.MessageAddress{Type: "admin", ID: reply.AdminID}
+	default:
+		part.Author = intercom.MessageAddress{Type: "user", ID: reply.IntercomID, UserID: reply.UserID, Email: reply.Email}
+	}
+	switch reply.ReplyType {
+	case "open":
+		conversation.Open = true
+	case "close":
+		conversation.Open = false
+	case "assignment":
+		conversation.Assignee = intercom.Admin{ID: intercom.ID(reply.AssigneeID)}
+	}
+	conversation.ConversationParts.Parts = append(conversation.ConversationParts.Parts, part)
+	return *conversation, nil
+}
+
+func hasTag(user intercom.User, tagID string) bool {
+	if user.Tags == nil {
+		return false
+	}
+	for _, tag := range user.Tags.Tags {
+		if tag.ID.String() == tagID {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *Fake) tagByName(name string) *intercom.Tag {
+	for i := range f.tags {
+		if f.tags[i].Name == name {
+			return &f.tags[i]
+		}
+	}
+	return nil
+}
+
+// saveTag creates a Tag, or tags Users with it, creating it if needed.
+func (f *Fake) saveTag(body []byte) (interface{}, error) {
+	taggingList := intercom.TaggingList{}
+	if err := json.Unmarshal(body, &taggingList); err != nil {
+		return nil, err
+	}
+	if taggingList.Name == "" {
+		return nil, invalid("Missing Tag Name")
+	}
+	tag := f.tagByName(taggingList.Name)
+	if tag == nil {
+		f.tags = append(f.tags, intercom.Tag{ID: intercom.ID(f.newID()), Name: taggingList.Name})
+		tag = &f.tags[len(f.tags)-1]
+	}
+	if len(taggingList.Users) > 0 || len(taggingList.Companies) > 0 {
+		f.taggings = append(f.taggings, taggingList)
+	}
+	for _, tagging := range taggingList.Users {
+		i := f.userIndex(func(u intercom.User) bool {
+			return (tagging.ID != "" && u.ID == tagging.ID) ||
+				(tagging.UserID != "" && u.UserID == tagging.UserID) ||
+				(tagging.Email != "" && u.Email == tagging.Email)
+		})
+		if i < 0 {
+			continue
+		}
+		f.users[i].Tags = applyTagging(f.users[i].Tags, *tag, tagging.Untag != nil && *tagging.Untag)
+	}
+	return *tag, nil
+}
+
+func applyTagging(tags *intercom.TagList, tag intercom.Tag, untag bool) *intercom.TagList {
+	applied := &intercom.TagList{}
+	if tags != nil {
+		for _, existing := range tags.Tags {
+			if existing.ID != tag.ID {
+				applied.Tags = append(applied.Tags, existing)
+			}
+		}
+	}
+	if !untag {
+		applied.Tags = append(applied.Tags, tag)
+	}
+	return applied
+}
+
+func (f *Fake) deleteTag(id string) (interface{}, error) {
+	for i, tag := range f.tags {
+		if tag.ID.String() == id {
+			f.tags = append(f.tags[:i], f.tags[i+1:]...)
+			return struct{}{}, nil
+		}
+	}
+	return nil, notFound("Tag")
+}
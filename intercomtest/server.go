@@ -0,0 +1,194 @@
+package intercomtest
+
+import (
+	"embed"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	intercom "gopkg.in/intercom/intercom-go.v2"
+)
+
+// fixtures are the responses the intercom package's own tests decode, so they stay in step with its structs.
+//
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// Fixture returns a fixture's JSON by its name, such as "conversation.json".
+// It panics if there's no such fixture.
+func Fixture(name string) []byte {
+	data, err := fixtures.ReadFile("fixtures/" + name)
+	if err != nil {
+		panic(fmt.Sprintf("intercomtest: no fixture %s", name))
+	}
+	return data
+}
+
+// FixtureHandler responds with a fixture's JSON, with a 200.
+func FixtureHandler(name string) http.HandlerFunc {
+	data := Fixture(name)
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}
+}
+
+// ErrorHandler responds with an Intercom error list, with a single error, and the status code.
+func ErrorHandler(status int, code, message string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `{"type":"error.list","errors":[{"code":%q,"message":%q}]}`, code, message)
+	}
+}
+
+// RateLimitHandler responds with a 429, with the rate limit headers Intercom sends
+// and a Retry-After of retryAfter seconds.
+func RateLimitHandler(retryAfter int) http.HandlerFunc {
+	data := Fixture("error_rate_limited.json")
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "1000")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write(data)
+	}
+}
+
+// Server is a httptest.Server which responds to requests to the main endpoints with fixtures,
+// for testing against realistic responses over HTTP, where the Fake is in memory:
+//
+//	server := intercomtest.NewServer()
+//	defer server.Close()
+//	server.Handle("GET", "/conversations/*", intercomtest.ErrorHandler(404, "not_found", "Conversation Not Found"))
+//	ic := server.Client()
+//
+// Requests to anything else get a 404. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	routes []route
+}
+
+type route struct {
+	method, pattern string
+	handler         http.Handler
+}
+
+// NewServer starts a Server, which should be closed when done with.
+func NewServer() *Server {
+	s := &Server{}
+	for _, r := range defaultRoutes {
+		s.Handle(r.method, r.pattern, r.handler)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+var defaultRoutes = []route{
+	{"GET", "/admins", FixtureHandler("admins.json")},
+	{"GET", "/admins/*", FixtureHandler("admin.json")},
+	{"GET", "/me", FixtureHandler("me.json")},
+	{"GET", "/users", queryHandler("users.json", map[string]string{"user_id": "user.json", "email": "user.json", "page": "users_page_2.json"})},
+	{"GET", "/users/*", FixtureHandler("user.json")},
+	{"GET", "/users/scroll", FixtureHandler("users.json")},
+	{"POST", "/users", FixtureHandler("user.json")},
+	{"DELETE", "/users/*", FixtureHandler("user.json")},
+	{"GET", "/contacts", queryHandler("contacts.json", map[string]string{"user_id": "contact.json"})},
+	{"GET", "/contacts/*", FixtureHandler("contact.json")},
+	{"POST", "/contacts", FixtureHandler("contact.json")},
+	{"GET", "/companies", queryHandler("companies.json", map[string]string{"company_id": "company.json", "name": "company.json"})},
+	{"GET", "/companies/*", FixtureHandler("company.json")},
+	{"GET", "/companies/scroll", FixtureHandler("companies.json")},
+	{"POST", "/companies", FixtureHandler("company.json")},
+	{"GET", "/conversations", FixtureHandler("conversations.json")},
+	{"GET", "/conversations/*", FixtureHandler("conversation.json")},
+	{"POST", "/conversations/*", FixtureHandler("conversation.json")},
+	{"POST", "/conversations/*/reply", FixtureHandler("conversation.json")},
+	{"GET", "/tags", FixtureHandler("tags.json")},
+	{"POST", "/tags", FixtureHandler("tag.json")},
+	{"DELETE", "/tags/*", FixtureHandler("tag.json")},
+	{"GET", "/events", queryHandler("events.json", map[string]string{"summary": "event_summaries.json"})},
+	{"POST", "/events", FixtureHandler("event.json")},
+	{"GET", "/segments", FixtureHandler("segments.json")},
+	{"GET", "/segments/*", FixtureHandler("segment.json")},
+	{"GET", "/teams", FixtureHandler("teams.json")},
+	{"GET", "/teams/*", FixtureHandler("team.json")},
+	{"GET", "/tickets/*", FixtureHandler("ticket.json")},
+	{"POST", "/tickets/*/reply", FixtureHandler("ticket_part.json")},
+	{"POST", "/messages", FixtureHandler("message.json")},
+	{"GET", "/counts", FixtureHandler("counts.json")},
+}
+
+// queryHandler responds with the fixture for the first of the request's query parameters it has one for,
+// for finding by a query parameter or paging, or otherwise with the list fixture.
+func queryHandler(list string, byParam map[string]string) http.HandlerFunc {
+	handlers := map[string]http.HandlerFunc{}
+	for param, name := range byParam {
+		handlers[param] = FixtureHandler(name)
+	}
+	listHandler := FixtureHandler(list)
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		for param, handler := range handlers {
+			if query.Get(param) != "" {
+				handler(w, r)
+				return
+			}
+		}
+		listHandler(w, r)
+	}
+}
+
+// Handle responds to requests matching the method and pattern with handler, instead of any handler
+// registered before. A * in the pattern matches any one path segment, such as an ID: /conversations/*/reply.
+func (s *Server) Handle(method, pattern string, handler http.Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes = append(s.routes, route{method: method, pattern: pattern, handler: handler})
+}
+
+// Client returns a new intercom.Client which makes its requests to the Server.
+func (s *Server) Client() *intercom.Client {
+	return intercom.NewClient("intercomtest", "intercomtest", intercom.BaseURI(s.URL))
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	var handler http.Handler = http.HandlerFunc(serveNotFound)
+	for i := len(s.routes) - 1; i >= 0; i-- {
+		if s.routes[i].method == r.Method && matchPath(s.routes[i].pattern, r.URL.Path) {
+			handler = s.routes[i].handler
+			break
+		}
+	}
+	s.mu.Unlock()
+	handler.ServeHTTP(w, r)
+}
+
+var notFoundData = Fixture("error_not_found.json")
+
+func serveNotFound(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	w.Write(notFoundData)
+}
+
+func matchPath(pattern, path string) bool {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternSegments) != len(pathSegments) {
+		return false
+	}
+	for i, segment := range patternSegments {
+		if segment != "*" && segment != pathSegments[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package intercomtest
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	intercom "gopkg.in/intercom/intercom-go.v2"
+)
+
+func TestServerFixtures(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	ic := server.Client()
+
+	convo, err := ic.Conversations.Find("147")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if convo.ID != "147" || convo.Assignee.ID != "25" {
+		t.Errorf("Conversation was %+v", convo)
+	}
+	convoList, err := ic.Conversations.ListAll(intercom.PageParams{})
+	if err != nil || len(convoList.Conversations) == 0 {
+		t.Errorf("Conversations were %+v, %v", convoList, err)
+	}
+	if _, err := ic.Conversations.Reply("147", &intercom.Admin{ID: "25"}, intercom.CONVERSATION_COMMENT, "Hi"); err != nil {
+		t.Errorf("Unexpected error replying: %v", err)
+	}
+
+	user, err := ic.Users.FindByEmail("myuser@example.io")
+	if err != nil || user.Email == "" {
+		t.Errorf("User was %+v, %v", user, err)
+	}
+	userList, err := ic.Users.List(intercom.PageParams{})
+	if err != nil || len(userList.Users) == 0 {
+		t.Fatalf("Users were %+v, %v", userList, err)
+	}
+	if _, err := ic.Users.List(intercom.PageParams{Page: 2}); err != nil {
+		t.Errorf("Unexpected error listing the next page: %v", err)
+	}
+
+	if _, err := ic.Articles.Find("1"); !errors.Is(err, intercom.ErrNotFound) {
+		t.Errorf("Expected a 404 for an unknown route, got %v", err)
+	}
+}
+
+func TestServerHandle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	ic := server.Client()
+
+	server.Handle("GET", "/conversations/*", ErrorHandler(http.StatusNotFound, "not_found", "Conversation Not Found"))
+	if _, err := ic.Conversations.Find("147"); !errors.Is(err, intercom.ErrNotFound) {
+		t.Errorf("Expected the custom handler's 404, got %v", err)
+	}
+	if _, err := ic.Conversations.ListAll(intercom.PageParams{}); err != nil {
+		t.Errorf("Expected other routes to be kept, got %v", err)
+	}
+
+	server.Handle("GET", "/users", RateLimitHandler(1))
+	_, err := ic.Users.List(intercom.PageParams{})
+	var limited intercom.RateLimitError
+	if !errors.As(err, &limited) || limited.RetryAfter != "1" || limited.Limit != 1000 {
+		t.Errorf("Expected a RateLimitError, got %#v", err)
+	}
+}
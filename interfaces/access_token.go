@@ -0,0 +1,32 @@
+package interfaces
+
+import "sync"
+
+// AccessToken holds an OAuth access token, sent as a Bearer token instead of basic auth when set.
+// It is safe to replace the token while requests are being made.
+type AccessToken struct {
+	mu    sync.RWMutex
+	token string
+}
+
+// NewAccessToken returns an AccessToken holding token.
+func NewAccessToken(token string) *AccessToken {
+	return &AccessToken{token: token}
+}
+
+// Get returns the token, which is empty if there is none.
+func (a *AccessToken) Get() string {
+	if a == nil {
+		return ""
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.token
+}
+
+// Set replaces the token. Requests already made keep the token they were made with.
+func (a *AccessToken) Set(token string) {
+	a.mu.Lock()
+	a.token = token
+	a.mu.Unlock()
+}
@@ -0,0 +1,45 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Clock tells the time and waits, for everything the client does which depends on time,
+// such as backing off between retries, so tests can control it. RealClock is the default.
+type Clock interface {
+	Now() time.Time
+	// After returns a channel which receives the time once d has passed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// RealClock is the Clock of the time package.
+type RealClock struct{}
+
+// Now returns time.Now().
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// After returns time.After(d).
+func (RealClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// clockOrReal returns the clock, or the RealClock if it's nil.
+func clockOrReal(clock Clock) Clock {
+	if clock == nil {
+		return RealClock{}
+	}
+	return clock
+}
+
+// sleep waits for d by the clock, or until the context is done.
+func sleep(ctx context.Context, clock Clock, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-clockOrReal(clock).After(d):
+		return nil
+	}
+}
@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+)
+
+// dumpRequest writes a request, with its body, to w, with its Authorization header redacted.
+func dumpRequest(w io.Writer, req *http.Request) {
+	dumped := hookRequest(req)
+	if dumped.Header.Get("Authorization") != "" {
+		dumped.Header.Set("Authorization", "[redacted]")
+	}
+	data, err := httputil.DumpRequestOut(dumped, true)
+	if err != nil {
+		fmt.Fprintf(w, "dumping %s %s: %s\n\n", req.Method, req.URL, err)
+		return
+	}
+	fmt.Fprintf(w, "%s\n\n", data)
+}
+
+// dumpResponse writes a response to w, with its body if body is set, leaving the body to be read again.
+// The body is decompressed first, if it was gzipped.
+func dumpResponse(w io.Writer, resp *http.Response, body bool) {
+	gunzipResponse(resp)
+	data, err := httputil.DumpResponse(resp, body)
+	if err != nil {
+		fmt.Fprintf(w, "dumping %s response: %s\n\n", resp.Status, err)
+		return
+	}
+	fmt.Fprintf(w, "%s\n\n", data)
+}
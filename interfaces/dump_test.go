@@ -0,0 +1,55 @@
+package interfaces
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestDumpHTTP(t *testing.T) {
+	server := newGzipServer(gzipped(t, `{"type":"user","id":"54c42e7e"}`))
+	defer server.Close()
+
+	var dump bytes.Buffer
+	var w io.Writer = &dump
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Dump = &w
+	data, err := client.Post("/users", map[string]string{"email": "bob@example.io"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `{"type":"user","id":"54c42e7e"}` {
+		t.Errorf("Response was %s, expected it to be decoded as normal", data)
+	}
+	for _, expected := range []string{"POST /users HTTP/1.1", `{"email":"bob@example.io"}`, "Authorization: [redacted]", "200 OK", `{"type":"user","id":"54c42e7e"}`} {
+		if !strings.Contains(dump.String(), expected) {
+			t.Errorf("Expected the dump to contain %q, got:\n%s", expected, dump.String())
+		}
+	}
+	if strings.Contains(dump.String(), "YXBwX2lkOmFwaV9rZXk=") {
+		t.Errorf("Expected credentials not to be dumped")
+	}
+}
+
+func TestDumpHTTPStream(t *testing.T) {
+	server := newGzipServer(gzipped(t, "export data"))
+	defer server.Close()
+
+	var dump bytes.Buffer
+	var w io.Writer = &dump
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Dump = &w
+	body, err := client.GetStream("/download/content/data/1", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer body.Close()
+	if data, _ := ioutil.ReadAll(body); string(data) != "export data" {
+		t.Errorf("Body was %s, expected it to be left to stream", data)
+	}
+	if !strings.Contains(dump.String(), "200 OK") || strings.Contains(dump.String(), "export data") {
+		t.Errorf("Expected the response without its body to be dumped, got:\n%s", dump.String())
+	}
+}
@@ -0,0 +1,86 @@
+package interfaces
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func gzipped(t testing.TB, data string) []byte {
+	var buffer bytes.Buffer
+	w := gzip.NewWriter(&buffer)
+	w.Write([]byte(data))
+	if err := w.Close(); err != nil {
+		t.Fatalf("Error gzipping: %v", err)
+	}
+	return buffer.Bytes()
+}
+
+func newGzipServer(body []byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") == "gzip" {
+			w.Header().Set("Content-Encoding", "gzip")
+		}
+		w.Write(body)
+	}))
+}
+
+func TestGzipResponse(t *testing.T) {
+	server := newGzipServer(gzipped(t, `{"type":"user.list","users":[]}`))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	data, err := client.Get("/users", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `{"type":"user.list","users":[]}` {
+		t.Errorf("Response was %q", data)
+	}
+}
+
+func TestGzipResponseTruncated(t *testing.T) {
+	body := gzipped(t, `{"type":"user.list","users":[]}`)
+	server := newGzipServer(body[:len(body)-6])
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	if _, err := client.Get("/users", nil); err != io.ErrUnexpectedEOF {
+		t.Errorf("Expected io.ErrUnexpectedEOF, got %v", err)
+	}
+}
+
+func TestGzipResponseNotGzip(t *testing.T) {
+	server := newGzipServer([]byte(`{"type":"user.list","users":[]}`))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	if _, err := client.Get("/users", nil); err != gzip.ErrHeader {
+		t.Errorf("Expected gzip.ErrHeader, got %v", err)
+	}
+}
+
+func BenchmarkGzipResponse(b *testing.B) {
+	var users bytes.Buffer
+	users.WriteString(`{"type":"user.list","users":[`)
+	for i := 0; i < 1000; i++ {
+		if i > 0 {
+			users.WriteString(",")
+		}
+		users.WriteString(`{"type":"user","email":"bob@example.io","name":"Bob","custom_attributes":{"plan":"pro"}}`)
+	}
+	users.WriteString(`]}`)
+	server := newGzipServer(gzipped(b, users.String()))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.Get("/users", nil); err != nil {
+			b.Fatalf("Unexpected error: %v", err)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+)
+
+type headersContextKey struct{}
+
+// WithHeaders returns a copy of ctx whose requests send header too, added to any headers from
+// earlier calls. Headers the client sets itself, such as Authorization and Accept, aren't replaced.
+func WithHeaders(ctx context.Context, header http.Header) context.Context {
+	merged := contextHeaders(ctx).Clone()
+	if merged == nil {
+		merged = http.Header{}
+	}
+	for key, values := range header {
+		merged[http.CanonicalHeaderKey(key)] = append([]string{}, values...)
+	}
+	return context.WithValue(ctx, headersContextKey{}, merged)
+}
+
+func contextHeaders(ctx context.Context) http.Header {
+	header, _ := ctx.Value(headersContextKey{}).(http.Header)
+	return header
+}
+
+// addHeaders adds headers to a request, those from its context first, then the defaults,
+// skipping any it already has, so the client's own headers are never replaced.
+func addHeaders(req *http.Request, defaults http.Header) {
+	for _, header := range []http.Header{contextHeaders(req.Context()), defaults} {
+		for key, values := range header {
+			key = http.CanonicalHeaderKey(key)
+			if _, ok := req.Header[key]; ok {
+				continue
+			}
+			req.Header[key] = append([]string{}, values...)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHeaders(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	defaults := http.Header{"X-Egress-Token": {"secret"}, "X-Correlation-Id": {"default"}, "Accept": {"text/html"}, "Authorization": {"Bearer stolen"}}
+	client := newTestIntercomHTTPClient(server.URL)
+	client.DefaultHeaders = &defaults
+	ctx := WithHeaders(context.Background(), http.Header{"x-correlation-id": {"op-1"}})
+	ctx = WithHeaders(ctx, http.Header{"X-Operation": {"sync"}})
+	if _, err := client.WithContext(ctx).Get("/users", nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for key, expected := range map[string]string{"X-Egress-Token": "secret", "X-Correlation-Id": "op-1", "X-Operation": "sync", "Accept": "application/json"} {
+		if values := received[key]; len(values) != 1 || values[0] != expected {
+			t.Errorf("%s was %v, expected %s", key, values, expected)
+		}
+	}
+	if user, _, ok := (&http.Request{Header: received}).BasicAuth(); !ok || user != "app_id" {
+		t.Errorf("Expected the client's Authorization to be kept, got %v", received["Authorization"])
+	}
+}
@@ -0,0 +1,47 @@
+package interfaces
+
+import (
+	"net/http"
+	"time"
+)
+
+// Hooks are called around every attempt at a request, including retries. Either may be nil.
+//
+// They are given copies of the request and response, so they can't consume the bodies
+// the client needs: the request's body can be read, unless it's a streamed upload, and the response's is empty.
+type Hooks struct {
+	OnRequest  func(*http.Request)
+	OnResponse func(*http.Request, *http.Response, time.Duration, error)
+}
+
+func (h *Hooks) request(req *http.Request) {
+	if h == nil || h.OnRequest == nil {
+		return
+	}
+	h.OnRequest(hookRequest(req))
+}
+
+func (h *Hooks) response(req *http.Request, resp *http.Response, duration time.Duration, err error) {
+	if h == nil || h.OnResponse == nil {
+		return
+	}
+	var hookResp *http.Response
+	if resp != nil {
+		copied := *resp
+		copied.Header = resp.Header.Clone()
+		copied.Body = http.NoBody
+		hookResp = &copied
+	}
+	h.OnResponse(hookRequest(req), hookResp, duration, err)
+}
+
+func hookRequest(req *http.Request) *http.Request {
+	copied := req.Clone(req.Context())
+	if req.GetBody != nil {
+		copied.Body, _ = req.GetBody()
+	} else if req.Body != nil {
+		// A streamed body can only be read once, by the request itself
+		copied.Body = http.NoBody
+	}
+	return copied
+}
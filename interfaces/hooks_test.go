@@ -0,0 +1,80 @@
+package interfaces
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHooks(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer server.Close()
+
+	var bodies []string
+	var statuses []int
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Retry = &RetryOptions{MaxAttempts: 2, BaseDelay: time.Millisecond}
+	client.Hooks = &Hooks{
+		OnRequest: func(req *http.Request) {
+			body, _ := ioutil.ReadAll(req.Body)
+			bodies = append(bodies, string(body))
+		},
+		OnResponse: func(req *http.Request, resp *http.Response, took time.Duration, err error) {
+			if body, _ := ioutil.ReadAll(resp.Body); len(body) != 0 {
+				t.Errorf("Expected the hook not to get the response body, got %s", body)
+			}
+			statuses = append(statuses, resp.StatusCode)
+		},
+	}
+	data, err := client.Put("/users", map[string]string{"email": "bob@example.io"})
+	if err != nil || string(data) != `{"id":"1"}` {
+		t.Fatalf("Got %s, %v", data, err)
+	}
+	if len(bodies) != 2 || bodies[1] != "{\"email\":\"bob@example.io\"}\n" {
+		t.Errorf("Request bodies were %q", bodies)
+	}
+	if len(statuses) != 2 || statuses[0] != 503 || statuses[1] != 200 {
+		t.Errorf("Response statuses were %v", statuses)
+	}
+}
+
+func TestHooksNil(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Hooks = &Hooks{}
+	if _, err := client.Get("/users", nil); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestHooksRequestID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "000e6gn3lfm5ja3fitr0")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var requestID string
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Hooks = &Hooks{OnResponse: func(req *http.Request, resp *http.Response, took time.Duration, err error) {
+		requestID = resp.Header.Get("X-Request-Id")
+	}}
+	client.Get("/users", nil)
+	if requestID != "000e6gn3lfm5ja3fitr0" {
+		t.Errorf("Request ID was %q, expected the response's", requestID)
+	}
+}
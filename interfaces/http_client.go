@@ -2,13 +2,16 @@ package interfaces
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
-
-	"github.com/google/go-querystring/query"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 )
 
 type HTTPClient interface {
@@ -18,55 +21,202 @@ type HTTPClient interface {
 	Delete(string, interface{}) ([]byte, error)
 }
 
+// HTTPPutClient is a HTTPClient which can also make PUT requests.
+// It is optional, so existing HTTPClients keep working for everything else.
+type HTTPPutClient interface {
+	HTTPClient
+	Put(string, interface{}) ([]byte, error)
+}
+
+// HTTPDeleteBodyClient is a HTTPClient which can also make DELETE requests with a JSON body,
+// for the endpoints which take one rather than query parameters.
+type HTTPDeleteBodyClient interface {
+	HTTPClient
+	DeleteWithBody(string, interface{}) ([]byte, error)
+}
+
+// HTTPStreamClient is a HTTPClient which can also stream a GET response body,
+// rather than reading it into memory. The caller must close the body.
+type HTTPStreamClient interface {
+	HTTPClient
+	GetStream(string, interface{}) (io.ReadCloser, error)
+}
+
+// HTTPJSONStreamClient is a HTTPClient which can also stream a GET response's JSON body,
+// so it can be decoded as it's read. The caller must close the body.
+type HTTPJSONStreamClient interface {
+	HTTPClient
+	GetJSONStream(string, interface{}) (io.ReadCloser, error)
+}
+
+// HTTPContextClient is a HTTPClient which can make its requests with a context.Context,
+// so they are cancelled, or time out, along with it.
+type HTTPContextClient interface {
+	HTTPClient
+	WithContext(context.Context) HTTPClient
+}
+
 type IntercomHTTPClient struct {
 	*http.Client
 	BaseURI       *string
 	AppID         string
 	APIKey        string
+	AccessToken   *AccessToken
 	ClientVersion *string
 	Debug         *bool
+	APIVersion    *string
+	Retry         *RetryOptions
+	RateLimits    *RateLimitRecorder
+	Counters      *StatsRecorder
+	Throttle      *Throttle
+	Hooks         *Hooks
+
+	// RetryPolicy decides whether to retry requests instead of Retry, when it is set.
+	RetryPolicy *RetryPolicy
+
+	// Clock times requests and waits between retries and for the Throttle, the RealClock if not set.
+	Clock *Clock
+
+	// Timeout is the default time a request has, including any retries; 0 means no timeout.
+	Timeout *time.Duration
+
+	// IdempotencyKeys generates an Idempotency-Key for each POST without one, kept across retries.
+	IdempotencyKeys *bool
+
+	// UserAgent is sent as the User-Agent header, instead of intercom-go/ClientVersion, when it is set.
+	UserAgent *string
+
+	// Dump is written the whole of each request and response, other than streamed response bodies, when it is set.
+	Dump *io.Writer
+
+	// Metrics observes every attempt at a request when it is set.
+	Metrics *Metrics
+
+	// DefaultHeaders are sent with every request, other than any the client sets itself.
+	DefaultHeaders *http.Header
+
+	// SettingsLock guards the settings pointed to above, so they can be changed while requests are
+	// being made. Each request reads them once, under the lock, when it starts.
+	SettingsLock *sync.RWMutex
+
+	ctx     context.Context
+	timeout time.Duration
 }
 
 func NewIntercomHTTPClient(appID, apiKey string, baseURI, clientVersion *string, debug *bool) IntercomHTTPClient {
-	return IntercomHTTPClient{Client: &http.Client{}, AppID: appID, APIKey: apiKey, BaseURI: baseURI, ClientVersion: clientVersion, Debug: debug}
+	return IntercomHTTPClient{Client: &http.Client{}, AppID: appID, APIKey: apiKey, BaseURI: baseURI, ClientVersion: clientVersion, Debug: debug, RateLimits: &RateLimitRecorder{}, Counters: &StatsRecorder{}}
+}
+
+// WithContext returns a copy of the IntercomHTTPClient which makes its requests with ctx.
+func (c IntercomHTTPClient) WithContext(ctx context.Context) HTTPClient {
+	c.ctx = ctx
+	return c
+}
+
+// RateLimit returns Intercom's rate limit, as of the latest response which reported it.
+func (c IntercomHTTPClient) RateLimit() RateLimitInfo {
+	return c.RateLimits.RateLimit()
+}
+
+// Stats returns counts of what the IntercomHTTPClient has done, across all of its copies.
+func (c IntercomHTTPClient) Stats() Stats {
+	return c.Counters.Stats()
+}
+
+func (c IntercomHTTPClient) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// settings returns a copy of the IntercomHTTPClient pointing at copies of its current settings,
+// read under the SettingsLock, for making a request with.
+func (c IntercomHTTPClient) settings() IntercomHTTPClient {
+	if c.SettingsLock == nil {
+		return c
+	}
+	c.SettingsLock.RLock()
+	defer c.SettingsLock.RUnlock()
+	c.BaseURI = copyOf(c.BaseURI)
+	c.ClientVersion = copyOf(c.ClientVersion)
+	c.Debug = copyOf(c.Debug)
+	c.APIVersion = copyOf(c.APIVersion)
+	c.Retry = copyOf(c.Retry)
+	c.RetryPolicy = copyOf(c.RetryPolicy)
+	c.Clock = copyOf(c.Clock)
+	c.Hooks = copyOf(c.Hooks)
+	c.Timeout = copyOf(c.Timeout)
+	c.IdempotencyKeys = copyOf(c.IdempotencyKeys)
+	c.UserAgent = copyOf(c.UserAgent)
+	c.Dump = copyOf(c.Dump)
+	c.Metrics = copyOf(c.Metrics)
+	c.DefaultHeaders = copyOf(c.DefaultHeaders)
+	return c
+}
+
+func copyOf[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
 }
 
 func (c IntercomHTTPClient) UserAgentHeader() string {
+	if c.UserAgent != nil && *c.UserAgent != "" {
+		return *c.UserAgent
+	}
 	return fmt.Sprintf("intercom-go/%s", *c.ClientVersion)
 }
 
 func (c IntercomHTTPClient) Get(url string, queryParams interface{}) ([]byte, error) {
-	// Setup request
-	req, _ := http.NewRequest("GET", *c.BaseURI+url, nil)
-	req.SetBasicAuth(c.AppID, c.APIKey)
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("User-Agent", c.UserAgentHeader())
-	addQueryParams(req, queryParams)
-	if *c.Debug {
-		fmt.Printf("%s %s\n", req.Method, req.URL)
-	}
+	return c.request("GET", url, queryParams, nil)
+}
 
-	// Do request
-	resp, err := c.Client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+func (c IntercomHTTPClient) GetStream(url string, queryParams interface{}) (io.ReadCloser, error) {
+	return c.stream(url, queryParams, "application/octet-stream")
+}
 
-	// Read response
-	data, err := c.readAll(resp.Body)
+func (c IntercomHTTPClient) GetJSONStream(url string, queryParams interface{}) (io.ReadCloser, error) {
+	return c.stream(url, queryParams, "application/json")
+}
+
+func (c IntercomHTTPClient) stream(url string, queryParams interface{}, accept string) (io.ReadCloser, error) {
+	c = c.settings()
+	resp, err := c.do("GET", url, queryParams, nil, accept)
 	if err != nil {
 		return nil, err
 	}
 	if resp.StatusCode >= 400 {
-		return nil, c.parseResponseError(data, resp.StatusCode)
+		defer resp.Body.Close()
+		data, err := c.readAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, c.parseResponseError(data, resp)
 	}
-	return data, err
+	return resp.Body, nil
 }
 
-func addQueryParams(req *http.Request, params interface{}) {
-	v, _ := query.Values(params)
-	req.URL.RawQuery = v.Encode()
+// requestURL is the URL for a request to a path, or to an absolute URL such as a pagination link.
+// Absolute URLs are sent to the BaseURI's host, so requests stay in its region
+// and credentials are never sent elsewhere.
+func (c IntercomHTTPClient) requestURL(path string) (string, error) {
+	if !strings.HasPrefix(path, "https://") && !strings.HasPrefix(path, "http://") {
+		return *c.BaseURI + path, nil
+	}
+	absolute, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	base, err := url.Parse(*c.BaseURI)
+	if err != nil {
+		return "", err
+	}
+	absolute.Scheme, absolute.Host, absolute.User = base.Scheme, base.Host, nil
+	absolute.Path = strings.TrimSuffix(base.Path, "/") + absolute.Path
+	return absolute.String(), nil
 }
 
 func (c IntercomHTTPClient) Patch(url string, body interface{}) ([]byte, error) {
@@ -77,28 +227,31 @@ func (c IntercomHTTPClient) Post(url string, body interface{}) ([]byte, error) {
 	return c.postOrPatch("POST", url, body)
 }
 
+func (c IntercomHTTPClient) Put(url string, body interface{}) ([]byte, error) {
+	return c.postOrPatch("PUT", url, body)
+}
+
 func (c IntercomHTTPClient) postOrPatch(method, url string, body interface{}) ([]byte, error) {
 	// Marshal our body
 	buffer := bytes.NewBuffer([]byte{})
 	if err := json.NewEncoder(buffer).Encode(body); err != nil {
 		return nil, err
 	}
+	return c.request(method, url, nil, &requestBody{data: buffer.Bytes(), contentType: "application/json"})
+}
 
-	// Setup request
-	req, err := http.NewRequest(method, *c.BaseURI+url, buffer)
-	if err != nil {
-		return nil, err
-	}
-	req.SetBasicAuth(c.AppID, c.APIKey)
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("User-Agent", c.UserAgentHeader())
-	if *c.Debug {
-		fmt.Printf("%s %s %s\n", req.Method, req.URL, buffer)
-	}
+func (c IntercomHTTPClient) Delete(url string, queryParams interface{}) ([]byte, error) {
+	return c.request("DELETE", url, queryParams, nil)
+}
 
-	// Do request
-	resp, err := c.Client.Do(req)
+func (c IntercomHTTPClient) DeleteWithBody(url string, body interface{}) ([]byte, error) {
+	return c.postOrPatch("DELETE", url, body)
+}
+
+// request makes a request expecting a JSON response, and reads it.
+func (c IntercomHTTPClient) request(method, url string, queryParams interface{}, body *requestBody) ([]byte, error) {
+	c = c.settings()
+	resp, err := c.do(method, url, queryParams, body, "application/json")
 	if err != nil {
 		return nil, err
 	}
@@ -107,41 +260,165 @@ func (c IntercomHTTPClient) postOrPatch(method, url string, body interface{}) ([
 	// Read response
 	data, err := c.readAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, c.timeoutError(err)
 	}
 	if resp.StatusCode >= 400 {
-		return nil, c.parseResponseError(data, resp.StatusCode)
+		return nil, c.parseResponseError(data, resp)
 	}
 	return data, err
 }
 
-func (c IntercomHTTPClient) Delete(url string, queryParams interface{}) ([]byte, error) {
-	// Setup request
-	req, _ := http.NewRequest("DELETE", *c.BaseURI+url, nil)
-	req.SetBasicAuth(c.AppID, c.APIKey)
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("User-Agent", c.UserAgentHeader())
-	addQueryParams(req, queryParams)
-	if *c.Debug {
-		fmt.Printf("%s %s\n", req.Method, req.URL)
+// do makes a request, retrying it if configured to, and returns the final response.
+// Its body must be closed, which ends the request's timeout.
+func (c IntercomHTTPClient) do(method, url string, queryParams interface{}, body *requestBody, accept string) (*http.Response, error) {
+	ctx, cancel := c.timeoutContext()
+	cancel = c.Counters.start(cancel)
+	resp, err := c.doAttempts(ctx, method, url, queryParams, body, accept)
+	if err != nil {
+		cancel()
+		return nil, c.timeoutError(err)
+	}
+	gunzipResponse(resp)
+	resp.Body = cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (c IntercomHTTPClient) doAttempts(ctx context.Context, method, url string, queryParams interface{}, body *requestBody, accept string) (*http.Response, error) {
+	key := idempotencyKey(ctx, method, c.IdempotencyKeys != nil && *c.IdempotencyKeys)
+	clock := c.clock()
+	started := clock.Now()
+	for attempt := 1; ; attempt++ {
+		// Setup request
+		req, err := c.newRequest(ctx, method, url, queryParams, body, accept)
+		if err != nil {
+			return nil, err
+		}
+		if key != "" {
+			req.Header.Add("Idempotency-Key", key)
+		}
+		var defaults http.Header
+		if c.DefaultHeaders != nil {
+			defaults = *c.DefaultHeaders
+		}
+		addHeaders(req, defaults)
+
+		// Do request
+		if err := c.Throttle.wait(ctx, clock); err != nil {
+			return nil, err
+		}
+		c.Hooks.request(req)
+		if c.Dump != nil && *c.Dump != nil {
+			dumpRequest(*c.Dump, req)
+		}
+		start := clock.Now()
+		resp, err := c.Client.Do(req)
+		now := clock.Now()
+		took := now.Sub(start)
+		c.Hooks.response(req, resp, took, err)
+		c.observe(method, url, resp, took, attempt, err)
+		if resp != nil {
+			c.Counters.response(resp)
+			c.RateLimits.record(resp.Header)
+			if c.Dump != nil && *c.Dump != nil {
+				dumpResponse(*c.Dump, resp, accept == "application/json")
+			}
+			if *c.Debug {
+				fmt.Printf("%s Intercom-Version: %s\n", resp.Status, resp.Header.Get("Intercom-Version"))
+			}
+		}
+		delay, retry := c.retryPolicy().RetryAfter(RetryAttempt{Attempt: attempt, Elapsed: now.Sub(started), Method: method, Path: req.URL.Path, Response: resp, Err: err, Now: now})
+		if !retry || !body.replayable() {
+			return resp, err
+		}
+		c.Counters.retry()
+		if resp != nil {
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}
+		if *c.Debug {
+			fmt.Printf("retrying %s %s in %s\n", req.Method, req.URL, delay)
+		}
+		if err := sleep(ctx, clock, delay); err != nil {
+			return nil, err
+		}
 	}
+}
 
-	// Do request
-	resp, err := c.Client.Do(req)
+func (c IntercomHTTPClient) clock() Clock {
+	if c.Clock == nil {
+		return RealClock{}
+	}
+	return clockOrReal(*c.Clock)
+}
+
+func (c IntercomHTTPClient) retryPolicy() RetryPolicy {
+	if c.RetryPolicy != nil && *c.RetryPolicy != nil {
+		return *c.RetryPolicy
+	}
+	if c.Retry == nil {
+		return RetryOptions{}
+	}
+	return *c.Retry
+}
+
+// requestBody is the body of a request, and its Content-Type.
+type requestBody struct {
+	data []byte
+
+	// stream is read as the body instead of data when it is set, so it can only be sent once.
+	stream      func() io.ReadCloser
+	contentType string
+}
+
+func (b *requestBody) reader() io.Reader {
+	if b.stream != nil {
+		return b.stream()
+	}
+	return bytes.NewReader(b.data)
+}
+
+// replayable is whether the body can be sent again, for a retry.
+func (b *requestBody) replayable() bool {
+	return b == nil || b.stream == nil
+}
+
+func (c IntercomHTTPClient) newRequest(ctx context.Context, method, url string, queryParams interface{}, body *requestBody, accept string) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = body.reader()
+	}
+	requestURL, err := c.requestURL(url)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	// Read response
-	data, err := c.readAll(resp.Body)
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode >= 400 {
-		return nil, c.parseResponseError(data, resp.StatusCode)
+	if token := c.AccessToken.Get(); token != "" {
+		req.Header.Add("Authorization", "Bearer "+token)
+	} else {
+		req.SetBasicAuth(c.AppID, c.APIKey)
 	}
-	return data, err
+	req.Header.Add("Accept", accept)
+	req.Header.Add("Accept-Encoding", "gzip")
+	if body != nil {
+		req.Header.Add("Content-Type", body.contentType)
+	} else {
+		addQueryParams(req, queryParams)
+	}
+	req.Header.Add("User-Agent", c.UserAgentHeader())
+	if c.APIVersion != nil && *c.APIVersion != "" {
+		req.Header.Add("Intercom-Version", *c.APIVersion)
+	}
+	if *c.Debug {
+		if body != nil && body.stream == nil {
+			fmt.Printf("%s %s %s\n", req.Method, req.URL, body.data)
+		} else {
+			fmt.Printf("%s %s\n", req.Method, req.URL)
+		}
+	}
+	return req, nil
 }
 
 type IntercomError interface {
@@ -151,18 +428,24 @@ type IntercomError interface {
 	GetMessage() string
 }
 
-func (c IntercomHTTPClient) parseResponseError(data []byte, statusCode int) IntercomError {
+func (c IntercomHTTPClient) parseResponseError(data []byte, resp *http.Response) IntercomError {
+	httpError := c.parseHTTPError(data, resp.StatusCode, resp.Header.Get("Content-Type"))
+	httpError.RequestID, httpError.Runtime = resp.Header.Get("X-Request-Id"), resp.Header.Get("X-Runtime")
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return newRateLimitError(httpError, resp.Header)
+	}
+	return httpError
+}
+
+// parseHTTPError decodes the errors in a JSON error response.
+// Responses which aren't, such as a proxy's HTML error page, keep the start of their body instead.
+func (c IntercomHTTPClient) parseHTTPError(data []byte, statusCode int, contentType string) HTTPError {
 	errorList := HTTPErrorList{}
 	err := json.Unmarshal(data, &errorList)
-	if err != nil {
-		return NewUnknownHTTPError(statusCode)
-	}
-	if len(errorList.Errors) == 0 {
-		return NewUnknownHTTPError(statusCode)
+	if err != nil || len(errorList.Errors) == 0 {
+		return NewRawHTTPError(statusCode, contentType, data)
 	}
-	httpError := errorList.Errors[0]
-	httpError.StatusCode = statusCode
-	return httpError // only care about the first
+	return newHTTPErrors(statusCode, errorList.Errors)
 }
 
 func (c IntercomHTTPClient) readAll(body io.Reader) ([]byte, error) {
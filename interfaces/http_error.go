@@ -1,8 +1,19 @@
 package interfaces
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+// Errors which HTTPErrors match with errors.Is, by their status code.
+var (
+	ErrUnauthorized = errors.New("Unauthorized")
+	ErrForbidden    = errors.New("Forbidden")
+	ErrNotFound     = errors.New("Not Found")
+	ErrRateLimited  = errors.New("Rate Limited")
 )
 
 type HTTPErrorList struct {
@@ -14,6 +25,36 @@ type HTTPError struct {
 	StatusCode int
 	Code       string `json:"code"`
 	Message    string `json:"message"`
+	Field      string `json:"field,omitempty"`
+
+	// ContentType and Body are set when the response wasn't a JSON error, such as an HTML page
+	// from a proxy. Body is the start of the response, up to MaxErrorBody bytes.
+	ContentType string `json:"-"`
+	Body        string `json:"-"`
+
+	// RequestID and Runtime are the response's X-Request-Id and X-Runtime headers, for Intercom's support.
+	RequestID string `json:"-"`
+	Runtime   string `json:"-"`
+
+	// details are all the errors in the response, behind a pointer so HTTPErrors stay comparable.
+	details *[]ErrorDetail
+}
+
+// ErrorDetail is one of the errors in an error response.
+type ErrorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Field   string `json:"field,omitempty"`
+}
+
+// MaxErrorBody is the most of a response which isn't a JSON error that is kept in a HTTPError's Body.
+const MaxErrorBody = 512
+
+// ValidationError is a HTTPError for a request rejected as invalid, with a 400 or 422.
+// Field is the parameter at fault, when Intercom says which.
+// HTTPErrors can be converted to it with errors.As.
+type ValidationError struct {
+	HTTPError
 }
 
 func NewUnknownHTTPError(statusCode int) HTTPError {
@@ -24,10 +65,65 @@ func NewUnknownHTTPError(statusCode int) HTTPError {
 	return HTTPError{Code: "Unknown", Message: message, StatusCode: statusCode}
 }
 
+// NewRawHTTPError is a HTTPError for a response which wasn't a JSON error, keeping the start of its body.
+func NewRawHTTPError(statusCode int, contentType string, body []byte) HTTPError {
+	httpError := NewUnknownHTTPError(statusCode)
+	if len(body) == 0 {
+		httpError.Message += " (Empty Body)"
+	}
+	if len(body) > MaxErrorBody {
+		body = body[:MaxErrorBody]
+		for i := 0; i < utf8.UTFMax-1 && !utf8.Valid(body); i++ {
+			body = body[:len(body)-1]
+		}
+	}
+	httpError.ContentType, httpError.Body = contentType, string(body)
+	return httpError
+}
+
+// newHTTPErrors is a HTTPError for the first of the errors in an error response, keeping them all.
+func newHTTPErrors(statusCode int, errorList []HTTPError) HTTPError {
+	httpError := errorList[0]
+	httpError.StatusCode = statusCode
+	details := make([]ErrorDetail, len(errorList))
+	for i, e := range errorList {
+		details[i] = ErrorDetail{Code: e.Code, Message: e.Message, Field: e.Field}
+	}
+	httpError.details = &details
+	return httpError
+}
+
 func (e HTTPError) Error() string {
+	message := e.message()
+	if e.RequestID != "" {
+		message += fmt.Sprintf(" (Request ID %s)", e.RequestID)
+	}
+	return message
+}
+
+func (e HTTPError) message() string {
+	if e.ContentType != "" || e.Body != "" {
+		return fmt.Sprintf("%d: %s, %s (Content-Type %q): %q", e.StatusCode, e.Code, e.Message, e.ContentType, e.Body)
+	}
+	if e.details != nil && len(*e.details) > 1 {
+		details := make([]string, len(*e.details))
+		for i, detail := range *e.details {
+			details[i] = fmt.Sprintf("%s, %s", detail.Code, detail.Message)
+		}
+		return fmt.Sprintf("%d: %s", e.StatusCode, strings.Join(details, "; "))
+	}
 	return fmt.Sprintf("%d: %s, %s", e.StatusCode, e.Code, e.Message)
 }
 
+// Errors are all of the errors in the response, such as each invalid field of a rejected request.
+// Code, Message and Field are those of the first.
+func (e HTTPError) Errors() []ErrorDetail {
+	if e.details == nil || len(*e.details) == 0 {
+		return []ErrorDetail{{Code: e.Code, Message: e.Message, Field: e.Field}}
+	}
+	return append([]ErrorDetail{}, *e.details...)
+}
+
 func (e HTTPError) GetStatusCode() int {
 	return e.StatusCode
 }
@@ -39,3 +135,28 @@ func (e HTTPError) GetCode() string {
 func (e HTTPError) GetMessage() string {
 	return e.Message
 }
+
+// Is reports whether the HTTPError matches one of the errors above, for errors.Is.
+func (e HTTPError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// As converts the HTTPError to a ValidationError, when it is one, for errors.As.
+func (e HTTPError) As(target interface{}) bool {
+	validationError, ok := target.(*ValidationError)
+	if !ok || (e.StatusCode != http.StatusBadRequest && e.StatusCode != http.StatusUnprocessableEntity) {
+		return false
+	}
+	*validationError = ValidationError{HTTPError: e}
+	return true
+}
@@ -0,0 +1,138 @@
+package interfaces
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHTTPErrorIs(t *testing.T) {
+	for _, test := range []struct {
+		statusCode int
+		target     error
+	}{
+		{401, ErrUnauthorized},
+		{403, ErrForbidden},
+		{404, ErrNotFound},
+		{429, ErrRateLimited},
+	} {
+		err := fmt.Errorf("wrapped: %w", HTTPError{StatusCode: test.statusCode})
+		if !errors.Is(err, test.target) {
+			t.Errorf("Expected a %d to be %v", test.statusCode, test.target)
+		}
+		if errors.Is(HTTPError{StatusCode: 500}, test.target) {
+			t.Errorf("Did not expect a 500 to be %v", test.target)
+		}
+	}
+}
+
+func TestHTTPErrorAsValidationError(t *testing.T) {
+	var invalid ValidationError
+	err := HTTPError{StatusCode: 400, Code: "parameter_invalid", Message: "Email is invalid", Field: "email"}
+	if !errors.As(err, &invalid) {
+		t.Fatalf("Expected a ValidationError")
+	}
+	if invalid.Field != "email" || invalid.GetCode() != "parameter_invalid" {
+		t.Errorf("ValidationError was %+v", invalid)
+	}
+	if errors.As(HTTPError{StatusCode: 404}, &invalid) {
+		t.Errorf("Did not expect a 404 to be a ValidationError")
+	}
+}
+
+func TestNonJSONErrorResponses(t *testing.T) {
+	for _, test := range []struct {
+		contentType, body string
+		expected          string
+	}{
+		{"text/html", "<html><body>502 Bad Gateway</body></html>", `502: Unknown, Bad Gateway (Content-Type "text/html"): "<html><body>502 Bad Gateway</body></html>"`},
+		{"", "", "502: Unknown, Bad Gateway (Empty Body)"},
+		{"application/json", `{"type": "error.list", "errors": [{"code": "service_unavailable", "message": "Sorry"}]}`, "502: service_unavailable, Sorry"},
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if test.contentType != "" {
+				w.Header().Set("Content-Type", test.contentType)
+			}
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte(test.body))
+		}))
+		_, err := newTestIntercomHTTPClient(server.URL).Get("/users", nil)
+		server.Close()
+		if err == nil || err.Error() != test.expected {
+			t.Errorf("Error was %v, expected %s", err, test.expected)
+		}
+		if httpError, ok := err.(HTTPError); !ok || httpError.StatusCode != http.StatusBadGateway {
+			t.Errorf("Expected a HTTPError with the status code, got %#v", err)
+		}
+	}
+}
+
+func TestNewRawHTTPErrorTruncates(t *testing.T) {
+	body := strings.Repeat("a", MaxErrorBody-1) + "é and more"
+	httpError := NewRawHTTPError(500, "text/plain", []byte(body))
+	if httpError.Body != strings.Repeat("a", MaxErrorBody-1) {
+		t.Errorf("Body was %d bytes, expected the first %d, without a partial rune", len(httpError.Body), MaxErrorBody-1)
+	}
+	if httpError.ContentType != "text/plain" || httpError.Message != "Internal Server Error" {
+		t.Errorf("HTTPError was %+v", httpError)
+	}
+}
+
+func TestMultipleErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"type": "error.list", "errors": [
+			{"code": "parameter_invalid", "message": "Email is invalid", "field": "email"},
+			{"code": "parameter_invalid", "message": "Phone is invalid", "field": "phone"}
+		]}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestIntercomHTTPClient(server.URL).Post("/users", nil)
+	if err == nil || err.Error() != "422: parameter_invalid, Email is invalid; parameter_invalid, Phone is invalid" {
+		t.Errorf("Error was %v, expected both errors", err)
+	}
+	var invalid ValidationError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("Expected a ValidationError")
+	}
+	details := invalid.Errors()
+	if len(details) != 2 || details[1] != (ErrorDetail{Code: "parameter_invalid", Message: "Phone is invalid", Field: "phone"}) {
+		t.Errorf("Errors were %+v, expected email and phone", details)
+	}
+	if invalid.Field != "email" {
+		t.Errorf("Field was %s, expected the first error's", invalid.Field)
+	}
+}
+
+func TestSingleErrorDetails(t *testing.T) {
+	err := HTTPError{StatusCode: 404, Code: "not_found", Message: "User Not Found"}
+	if details := err.Errors(); len(details) != 1 || details[0].Code != "not_found" {
+		t.Errorf("Errors were %+v, expected the HTTPError itself", details)
+	}
+	if err.Error() != "404: not_found, User Not Found" {
+		t.Errorf("Error was %s", err.Error())
+	}
+}
+
+func TestErrorRequestID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "000e6gn3lfm5ja3fitr0")
+		w.Header().Set("X-Runtime", "0.042")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"type": "error.list", "errors": [{"code": "not_found", "message": "User Not Found"}]}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestIntercomHTTPClient(server.URL).Get("/users/1", nil)
+	httpError, ok := err.(HTTPError)
+	if !ok || httpError.RequestID != "000e6gn3lfm5ja3fitr0" || httpError.Runtime != "0.042" {
+		t.Fatalf("Error was %#v, expected the request ID and runtime", err)
+	}
+	if err.Error() != "404: not_found, User Not Found (Request ID 000e6gn3lfm5ja3fitr0)" {
+		t.Errorf("Error was %s, expected it to include the request ID", err)
+	}
+}
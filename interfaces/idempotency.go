@@ -0,0 +1,33 @@
+package interfaces
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a copy of ctx whose POST requests send key as their Idempotency-Key header.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// idempotencyKey is the Idempotency-Key to send with a request: the one from its context,
+// or else a new one if generate is set. It is empty for requests other than POSTs.
+func idempotencyKey(ctx context.Context, method string, generate bool) string {
+	if method != "POST" {
+		return ""
+	}
+	if key, ok := ctx.Value(idempotencyKeyContextKey{}).(string); ok && key != "" {
+		return key
+	}
+	if !generate {
+		return ""
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
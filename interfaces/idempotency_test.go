@@ -0,0 +1,52 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	generate := true
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Retry = &RetryOptions{MaxAttempts: 2, BaseDelay: time.Millisecond}
+	client.IdempotencyKeys = &generate
+	client.Post("/users", nil)
+	if len(keys) != 2 || keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("Expected the same generated key across retries, got %q", keys)
+	}
+
+	keys = nil
+	client.WithContext(WithIdempotencyKey(context.Background(), "chosen")).Post("/users", nil)
+	if len(keys) != 2 || keys[0] != "chosen" || keys[1] != "chosen" {
+		t.Errorf("Expected the chosen key, got %q", keys)
+	}
+
+	keys = []string{"skip the 429"}
+	client.Get("/users", nil)
+	if keys[1] != "" {
+		t.Errorf("Expected no key for a GET, got %s", keys[1])
+	}
+}
+
+func TestIdempotencyKeyOff(t *testing.T) {
+	if key := idempotencyKey(context.Background(), "POST", false); key != "" {
+		t.Errorf("Expected no key, got %s", key)
+	}
+	if key := idempotencyKey(context.Background(), "POST", true); len(key) != 32 {
+		t.Errorf("Expected a generated key, got %s", key)
+	}
+}
@@ -0,0 +1,42 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Metrics observes every attempt at a request, including retries, for recording call rates,
+// latencies and errors. The path is the endpoint, such as /conversations/{id}, rather than the URL,
+// so it can be used as a label. The status is 0 if there was an error instead of a response.
+// It may be called from many goroutines at once.
+type Metrics interface {
+	ObserveRequest(method, path string, status int, duration time.Duration, attempt int, err error)
+}
+
+// endpoint is the path of a request URL, with its IDs replaced with {id}. Path segments are IDs
+// unless they're made up of lowercase letters and underscores, like every resource and action.
+func endpoint(requestURL string) string {
+	if u, err := url.Parse(requestURL); err == nil {
+		requestURL = u.Path
+	}
+	segments := strings.Split(requestURL, "/")
+	for i, segment := range segments {
+		if segment != "" && strings.Trim(segment, "abcdefghijklmnopqrstuvwxyz_") != "" {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func (c IntercomHTTPClient) observe(method, url string, resp *http.Response, duration time.Duration, attempt int, err error) {
+	if c.Metrics == nil || *c.Metrics == nil {
+		return
+	}
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	(*c.Metrics).ObserveRequest(method, endpoint(url), status, duration, attempt, err)
+}
@@ -0,0 +1,67 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEndpoint(t *testing.T) {
+	for url, expected := range map[string]string{
+		"/conversations/147":                               "/conversations/{id}",
+		"/conversations/147/reply":                         "/conversations/{id}/reply",
+		"/users":                                           "/users",
+		"/users/scroll":                                    "/users/scroll",
+		"/contacts/54c42e7ea7a765fa7":                      "/contacts/{id}",
+		"/subscriptions/nsub_123456789/sent":               "/subscriptions/{id}/sent",
+		"/jobs/job_5ca1ab1eca11ab1e":                       "/jobs/{id}",
+		"/help_center/collections/165":                     "/help_center/collections/{id}",
+		"https://api.intercom.io/users?per_page=50&page=2": "/users",
+	} {
+		if actual := endpoint(url); actual != expected {
+			t.Errorf("Endpoint of %s was %s, expected %s", url, actual, expected)
+		}
+	}
+}
+
+type testMetrics struct {
+	sync.Mutex
+	observed []string
+	attempts []int
+}
+
+func (m *testMetrics) ObserveRequest(method, path string, status int, duration time.Duration, attempt int, err error) {
+	m.Lock()
+	defer m.Unlock()
+	m.observed = append(m.observed, method+" "+path+" "+http.StatusText(status))
+	m.attempts = append(m.attempts, attempt)
+}
+
+func TestMetrics(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var metrics Metrics = &testMetrics{}
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Retry = &RetryOptions{MaxAttempts: 2, BaseDelay: time.Millisecond}
+	client.Metrics = &metrics
+	client.Get("/conversations/147", nil)
+
+	observed := metrics.(*testMetrics)
+	if len(observed.observed) != 2 || observed.observed[0] != "GET /conversations/{id} Service Unavailable" || observed.observed[1] != "GET /conversations/{id} OK" {
+		t.Errorf("Observed %q", observed.observed)
+	}
+	if len(observed.attempts) != 2 || observed.attempts[1] != 2 {
+		t.Errorf("Attempts were %v, expected 1 and 2", observed.attempts)
+	}
+}
@@ -0,0 +1,110 @@
+package interfaces
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/textproto"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// HTTPMultipartClient is a HTTPClient which can also POST multipart/form-data, to upload files.
+type HTTPMultipartClient interface {
+	HTTPClient
+	PostMultipart(string, map[string]string, []UploadFile) ([]byte, error)
+}
+
+// UploadFile is a file sent as part of a multipart/form-data request.
+type UploadFile struct {
+	// FieldName is the form field the file is sent as.
+	FieldName string
+	FileName  string
+
+	// ContentType defaults to application/octet-stream.
+	ContentType string
+
+	// Content is read as the request is sent, rather than into memory first.
+	Content io.Reader
+}
+
+// PostMultipart POSTs fields and files as multipart/form-data, streaming the files' contents.
+// As the files can only be read once, the request is never retried.
+func (c IntercomHTTPClient) PostMultipart(url string, fields map[string]string, files []UploadFile) ([]byte, error) {
+	// Pick the boundary up front, for the Content-Type
+	boundary := multipart.NewWriter(ioutil.Discard).Boundary()
+	body := &requestBody{
+		stream: func() io.ReadCloser {
+			return &multipartBody{boundary: boundary, fields: fields, files: files}
+		},
+		contentType: "multipart/form-data; boundary=" + boundary,
+	}
+	return c.request("POST", url, nil, body)
+}
+
+// multipartBody writes its form through a pipe once it's first read, so nothing is written
+// for a request which is never sent. Closing it stops the writing.
+type multipartBody struct {
+	boundary string
+	fields   map[string]string
+	files    []UploadFile
+
+	once   sync.Once
+	reader *io.PipeReader
+	writer *io.PipeWriter
+}
+
+func (b *multipartBody) start() {
+	b.reader, b.writer = io.Pipe()
+	go func() {
+		b.writer.CloseWithError(b.write())
+	}()
+}
+
+func (b *multipartBody) Read(p []byte) (int, error) {
+	b.once.Do(b.start)
+	return b.reader.Read(p)
+}
+
+func (b *multipartBody) Close() error {
+	b.once.Do(b.start)
+	return b.reader.Close()
+}
+
+func (b *multipartBody) write() error {
+	w := multipart.NewWriter(b.writer)
+	if err := w.SetBoundary(b.boundary); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(b.fields))
+	for key := range b.fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := w.WriteField(key, b.fields[key]); err != nil {
+			return err
+		}
+	}
+	for _, file := range b.files {
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, quoteEscaper.Replace(file.FieldName), quoteEscaper.Replace(file.FileName)))
+		header.Set("Content-Type", contentType)
+		part, err := w.CreatePart(header)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(part, file.Content); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
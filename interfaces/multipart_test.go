@@ -0,0 +1,80 @@
+package interfaces
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPostMultipart(t *testing.T) {
+	var fields, files, contentTypes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		fields = append(fields, r.FormValue("type"))
+		for _, header := range r.MultipartForm.File["file"] {
+			file, _ := header.Open()
+			data, _ := ioutil.ReadAll(file)
+			files = append(files, header.Filename+":"+string(data))
+			contentTypes = append(contentTypes, header.Header.Get("Content-Type"))
+		}
+		w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	data, err := client.PostMultipart("/uploads", map[string]string{"type": "attachment"}, []UploadFile{
+		{FieldName: "file", FileName: "notes.txt", ContentType: "text/plain", Content: strings.NewReader("some notes")},
+		{FieldName: "file", FileName: `"quoted".bin`, Content: strings.NewReader("\x00\x01")},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `{"id":"1"}` {
+		t.Errorf("Response was %s", data)
+	}
+	if strings.Join(fields, ",") != "attachment" || strings.Join(files, ",") != "notes.txt:some notes,\"quoted\".bin:\x00\x01" {
+		t.Errorf("Uploaded %q and %q", fields, files)
+	}
+	if strings.Join(contentTypes, ",") != "text/plain,application/octet-stream" {
+		t.Errorf("Content types were %q", contentTypes)
+	}
+}
+
+func TestPostMultipartNotRetried(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Retry = &RetryOptions{MaxAttempts: 3}
+	_, err := client.PostMultipart("/uploads", nil, []UploadFile{{FieldName: "file", FileName: "a.txt", Content: strings.NewReader("a")}})
+	if _, ok := err.(RateLimitError); !ok || requests != 1 {
+		t.Errorf("Got %v after %d requests, expected a RateLimitError after 1", err, requests)
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("disk on fire") }
+
+func TestPostMultipartReadError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	if _, err := client.PostMultipart("/uploads", nil, []UploadFile{{FieldName: "file", FileName: "a.txt", Content: failingReader{}}}); err == nil || !strings.Contains(err.Error(), "disk on fire") {
+		t.Errorf("Expected the file's error, got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+// addQueryParams adds params, encoded from their url tags, to the request's query, replacing
+// any values its URL already has for the same keys. The URL's other values are kept as they were
+// encoded, rather than being decoded and encoded again.
+func addQueryParams(req *http.Request, params interface{}) {
+	v, _ := query.Values(params)
+	if len(v) == 0 {
+		return
+	}
+	pairs := []string{}
+	if req.URL.RawQuery != "" {
+		for _, pair := range strings.Split(req.URL.RawQuery, "&") {
+			key, _ := url.QueryUnescape(strings.SplitN(pair, "=", 2)[0])
+			if _, replaced := v[key]; !replaced {
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+	if encoded := encodeQuery(v); encoded != "" {
+		pairs = append(pairs, encoded)
+	}
+	req.URL.RawQuery = strings.Join(pairs, "&")
+}
+
+// encodeQuery encodes values like url.Values.Encode, but with spaces as %20 rather than +,
+// so no server can mistake one for a +, which is always encoded as %2B.
+func encodeQuery(v url.Values) string {
+	keys := make([]string, 0, len(v))
+	for key := range v {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := []string{}
+	for _, key := range keys {
+		for _, value := range v[key] {
+			pairs = append(pairs, queryEscape(key)+"="+queryEscape(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+func queryEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
@@ -0,0 +1,41 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type testQueryParams struct {
+	Email string `url:"email,omitempty"`
+	Name  string `url:"name,omitempty"`
+	Tag   string `url:"tag_name,omitempty"`
+}
+
+func TestQueryParamsEncoding(t *testing.T) {
+	var rawQuery string
+	var values url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawQuery, values = r.URL.RawQuery, r.URL.Query()
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	params := testQueryParams{Email: "foo+test@example.com", Name: "Zoë & Bob", Tag: "vip=yes 100%"}
+	if _, err := client.Get("/users", params); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := "email=foo%2Btest%40example.com&name=Zo%C3%AB%20%26%20Bob&tag_name=vip%3Dyes%20100%25"; rawQuery != expected {
+		t.Errorf("Query was %s, expected %s", rawQuery, expected)
+	}
+	if values.Get("email") != params.Email || values.Get("name") != params.Name || values.Get("tag_name") != params.Tag {
+		t.Errorf("Query values were %v", values)
+	}
+
+	client.Get(server.URL+"/users?email=jo%2Bme%40example.com&page=2&tag_name=old", testQueryParams{Tag: "new"})
+	if expected := "email=jo%2Bme%40example.com&page=2&tag_name=new"; rawQuery != expected {
+		t.Errorf("Query was %s, expected %s", rawQuery, expected)
+	}
+}
@@ -0,0 +1,105 @@
+package interfaces
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// HTTPRateLimitClient is a HTTPClient which keeps track of Intercom's rate limit.
+type HTTPRateLimitClient interface {
+	HTTPClient
+	RateLimit() RateLimitInfo
+}
+
+// RateLimitInfo is Intercom's rate limit, as of the latest response which reported it.
+type RateLimitInfo struct {
+	// Limit is the number of requests allowed in each period.
+	Limit int
+
+	// Remaining is the number of requests left in the current period.
+	Remaining int
+
+	// Reset is when the current period ends, and Remaining goes back up to Limit.
+	Reset time.Time
+}
+
+// RateLimitRecorder records the RateLimitInfo from response headers. It is safe for concurrent use.
+type RateLimitRecorder struct {
+	mu   sync.RWMutex
+	info RateLimitInfo
+}
+
+// RateLimit returns the latest RateLimitInfo, which is zero until a response has reported it.
+func (r *RateLimitRecorder) RateLimit() RateLimitInfo {
+	if r == nil {
+		return RateLimitInfo{}
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.info
+}
+
+func (r *RateLimitRecorder) record(header http.Header) {
+	if r == nil {
+		return
+	}
+	info, ok := parseRateLimit(header)
+	if !ok {
+		return
+	}
+	r.mu.Lock()
+	r.info = info
+	r.mu.Unlock()
+}
+
+// RateLimitError is the HTTPError returned for a request which was rate limited, with a 429.
+// It matches ErrRateLimited with errors.Is, and can be got with errors.As.
+type RateLimitError struct {
+	HTTPError
+	RateLimitInfo
+
+	// RetryAfter is the raw Retry-After header, which is empty if there was none.
+	RetryAfter string
+}
+
+func newRateLimitError(httpError HTTPError, header http.Header) RateLimitError {
+	info, _ := parseRateLimit(header)
+	return RateLimitError{HTTPError: httpError, RateLimitInfo: info, RetryAfter: header.Get("Retry-After")}
+}
+
+// RetryDelay is how long to wait, from now, before retrying: from Retry-After, in seconds or
+// as a HTTP date, or else until Reset. It is false if neither is known.
+func (e RateLimitError) RetryDelay(now time.Time) (time.Duration, bool) {
+	if e.RetryAfter != "" {
+		if seconds, err := strconv.Atoi(e.RetryAfter); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if at, err := http.ParseTime(e.RetryAfter); err == nil {
+			return nonNegative(at.Sub(now)), true
+		}
+	}
+	if !e.Reset.IsZero() {
+		return nonNegative(e.Reset.Sub(now)), true
+	}
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+func parseRateLimit(header http.Header) (RateLimitInfo, bool) {
+	info := RateLimitInfo{}
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	info.Limit = limit
+	info.Remaining, _ = strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		info.Reset = time.Unix(reset, 0)
+	}
+	return info, err == nil
+}
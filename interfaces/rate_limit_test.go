@@ -0,0 +1,81 @@
+package interfaces
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimitRecorded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "500")
+		w.Header().Set("X-RateLimit-Remaining", "499")
+		w.Header().Set("X-RateLimit-Reset", "1500000010")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	if limit := client.RateLimit(); limit != (RateLimitInfo{}) {
+		t.Errorf("Expected no rate limit before any requests, got %+v", limit)
+	}
+	client.Get("/users", nil)
+	limit := client.RateLimit()
+	if limit.Limit != 500 || limit.Remaining != 499 || !limit.Reset.Equal(time.Unix(1500000010, 0)) {
+		t.Errorf("Rate limit was %+v", limit)
+	}
+}
+
+func TestRateLimitKeptWithoutHeaders(t *testing.T) {
+	recorder := &RateLimitRecorder{}
+	recorder.record(http.Header{"X-Ratelimit-Limit": {"500"}, "X-Ratelimit-Remaining": {"12"}})
+	recorder.record(http.Header{})
+	if limit := recorder.RateLimit(); limit.Remaining != 12 {
+		t.Errorf("Rate limit was %+v", limit)
+	}
+	var none *RateLimitRecorder
+	if limit := none.RateLimit(); limit != (RateLimitInfo{}) {
+		t.Errorf("Rate limit was %+v", limit)
+	}
+}
+
+func TestRateLimitError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "500")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1500000010")
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"type":"error.list","errors":[{"code":"rate_limit_exceeded","message":"Exceeded rate limit"}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	_, err := client.Get("/users", nil)
+	var limited RateLimitError
+	if !errors.As(err, &limited) {
+		t.Fatalf("Expected a RateLimitError, got %#v", err)
+	}
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Expected ErrRateLimited")
+	}
+	if limited.GetCode() != "rate_limit_exceeded" || limited.Remaining != 0 || limited.Limit != 500 || limited.RetryAfter != "5" {
+		t.Errorf("RateLimitError was %+v", limited)
+	}
+	if delay, ok := limited.RetryDelay(time.Unix(1500000000, 0)); !ok || delay != 5*time.Second {
+		t.Errorf("Retry delay was %s", delay)
+	}
+}
+
+func TestRateLimitErrorRetryDelay(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	limited := RateLimitError{RateLimitInfo: RateLimitInfo{Reset: now.Add(8 * time.Second)}}
+	if delay, ok := limited.RetryDelay(now); !ok || delay != 8*time.Second {
+		t.Errorf("Retry delay was %s", delay)
+	}
+	if _, ok := (RateLimitError{}).RetryDelay(now); ok {
+		t.Errorf("Expected no retry delay")
+	}
+}
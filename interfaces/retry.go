@@ -0,0 +1,156 @@
+package interfaces
+
+import (
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	defaultRetryMaxDelay  = 30 * time.Second
+)
+
+// RetryOptions configures retrying requests which are rate limited (429),
+// or fail with a 502, 503 or 504, or without a response at all.
+//
+// Delays back off exponentially from BaseDelay, with jitter, up to MaxDelay,
+// unless the response says when to retry with a Retry-After header, or, when rate limited, X-RateLimit-Reset.
+type RetryOptions struct {
+	// MaxAttempts is the most times a request is made, including the first.
+	// Retrying is off unless it is more than 1.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry. Defaults to 500ms.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay before each attempt. Defaults to 30s.
+	MaxDelay time.Duration
+
+	// MaxElapsed is the longest a request is retried for, from when its first attempt started.
+	// A retry which would start after it isn't made, and the last response or error is returned instead.
+	// There is no limit if it is 0.
+	MaxElapsed time.Duration
+
+	// RetryNonIdempotent also retries POST and PATCH requests after server errors or
+	// lost responses, which may have been processed. Rate limited requests are always retried.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryOptions are conservative RetryOptions, for failing fast when called while handling a request:
+// up to 3 attempts, with delays from 500ms up to 5s, for no more than 10s in all.
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{MaxAttempts: 3, BaseDelay: defaultRetryBaseDelay, MaxDelay: 5 * time.Second, MaxElapsed: 10 * time.Second}
+}
+
+// RetryAttempt is an attempt at a request, for a RetryPolicy to decide whether to retry it.
+type RetryAttempt struct {
+	// Attempt is how many attempts have been made, including this one, from 1.
+	Attempt int
+	// Elapsed is how long it's been since the first attempt started.
+	Elapsed time.Duration
+	Method  string
+	// Path is the path of the request's URL, such as /events.
+	Path string
+	// Response is the attempt's response, or nil if it failed without one, with Err.
+	Response *http.Response
+	Err      error
+	// Now is when the attempt finished, by the client's Clock, for reading Retry-After dates against.
+	Now time.Time
+}
+
+// RetryPolicy decides whether a request should be retried after an attempt, and how long to wait first.
+// RetryOptions is the default policy, which a custom one can delegate to for the cases it doesn't handle:
+//
+//	type noEventRetries struct{ interfaces.RetryOptions }
+//
+//	func (p noEventRetries) RetryAfter(attempt interfaces.RetryAttempt) (time.Duration, bool) {
+//		if attempt.Method == "POST" && attempt.Path == "/events" {
+//			return 0, false
+//		}
+//		return p.RetryOptions.RetryAfter(attempt)
+//	}
+//
+// Streamed request bodies are never retried, whatever the policy decides.
+type RetryPolicy interface {
+	RetryAfter(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// RetryAfter implements RetryPolicy, as described on RetryOptions.
+func (r RetryOptions) RetryAfter(attempt RetryAttempt) (time.Duration, bool) {
+	return r.retryAfter(attempt)
+}
+
+// retryAfter decides whether a request should be retried, and after how long,
+// given how long has elapsed since its first attempt started.
+func (r *RetryOptions) retryAfter(attempt RetryAttempt) (time.Duration, bool) {
+	delay, retry := r.delay(attempt)
+	if retry && r.MaxElapsed > 0 && attempt.Elapsed+delay > r.MaxElapsed {
+		return 0, false
+	}
+	return delay, retry
+}
+
+func (r *RetryOptions) delay(attempt RetryAttempt) (time.Duration, bool) {
+	if r == nil || attempt.Attempt >= r.MaxAttempts {
+		return 0, false
+	}
+	resp, now := attempt.Response, attempt.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	idempotent := attempt.Method != "POST" && attempt.Method != "PATCH"
+	switch {
+	case attempt.Err != nil:
+		if !idempotent && !r.RetryNonIdempotent {
+			return 0, false
+		}
+	case resp.StatusCode == http.StatusTooManyRequests:
+	case resp.StatusCode == http.StatusBadGateway, resp.StatusCode == http.StatusServiceUnavailable, resp.StatusCode == http.StatusGatewayTimeout:
+		if !idempotent && !r.RetryNonIdempotent {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	maxDelay := r.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultRetryMaxDelay
+	}
+	if resp != nil {
+		if delay, ok := headerDelay(resp.Header, now, resp.StatusCode == http.StatusTooManyRequests); ok {
+			if delay > maxDelay {
+				delay = maxDelay
+			}
+			return delay, true
+		}
+	}
+	return r.backoff(attempt.Attempt, maxDelay), true
+}
+
+// backoff is a random delay of between half and all of BaseDelay*2^(attempt-1), capped at maxDelay.
+func (r *RetryOptions) backoff(attempt int, maxDelay time.Duration) time.Duration {
+	delay := r.BaseDelay
+	if delay <= 0 {
+		delay = defaultRetryBaseDelay
+	}
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// headerDelay reads how long to wait before retrying from the Retry-After header,
+// in seconds or as a HTTP date, or, if rateLimited, from X-RateLimit-Reset, as a Unix time.
+// A server error's X-RateLimit-Reset says nothing about when the server will recover.
+func headerDelay(header http.Header, now time.Time, rateLimited bool) (time.Duration, bool) {
+	rateLimitError := newRateLimitError(HTTPError{}, header)
+	if !rateLimited {
+		rateLimitError.Reset = time.Time{}
+	}
+	return rateLimitError.RetryDelay(now)
+}
@@ -0,0 +1,220 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryRateLimited(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Retry = &RetryOptions{MaxAttempts: 3}
+	data, err := client.Post("/users", map[string]string{"email": "bob@example.io"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `{"id":"1"}` || requests != 3 {
+		t.Errorf("Got %s after %d requests", data, requests)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Retry = &RetryOptions{MaxAttempts: 2, BaseDelay: time.Millisecond}
+	_, err := client.Get("/users", nil)
+	if herr, ok := err.(HTTPError); !ok || herr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected a 503, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestRetryMaxElapsed(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "2")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Retry = &RetryOptions{MaxAttempts: 5, MaxElapsed: time.Second}
+	start := time.Now()
+	_, err := client.Get("/users", nil)
+	if _, ok := err.(RateLimitError); !ok {
+		t.Errorf("Expected the last RateLimitError, got %v", err)
+	}
+	if requests != 1 || time.Since(start) > time.Second {
+		t.Errorf("Expected to give up rather than wait past MaxElapsed, made %d requests in %s", requests, time.Since(start))
+	}
+}
+
+func TestRetryElapsed(t *testing.T) {
+	retry := &RetryOptions{MaxAttempts: 3, BaseDelay: time.Second, MaxElapsed: 3 * time.Second}
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	if _, ok := retry.retryAfter(RetryAttempt{Attempt: 1, Method: "GET", Response: resp}); !ok {
+		t.Errorf("Expected a retry within MaxElapsed")
+	}
+	if _, ok := retry.retryAfter(RetryAttempt{Attempt: 2, Elapsed: 2500 * time.Millisecond, Method: "GET", Response: resp}); ok {
+		t.Errorf("Expected no retry past MaxElapsed")
+	}
+	retry.MaxElapsed = 0
+	if _, ok := retry.retryAfter(RetryAttempt{Attempt: 2, Elapsed: time.Hour, Method: "GET", Response: resp}); !ok {
+		t.Errorf("Expected no limit without MaxElapsed")
+	}
+}
+
+func TestDefaultRetryOptions(t *testing.T) {
+	retry := DefaultRetryOptions()
+	if retry.MaxAttempts != 3 || retry.MaxElapsed != 10*time.Second || retry.MaxDelay > retry.MaxElapsed {
+		t.Errorf("DefaultRetryOptions were %+v", retry)
+	}
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Retry = &RetryOptions{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	client.Post("/users", nil)
+	if requests != 1 {
+		t.Errorf("Expected POST not to be retried, got %d requests", requests)
+	}
+
+	requests = 0
+	client.Retry.RetryNonIdempotent = true
+	client.Post("/users", nil)
+	if requests != 3 {
+		t.Errorf("Expected POST to be retried, got %d requests", requests)
+	}
+}
+
+func TestRetryOff(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Get("/users", nil)
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	retry := RetryOptions{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for attempt, max := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second} {
+		if delay := retry.backoff(attempt, retry.MaxDelay); delay < max/2 || delay > max {
+			t.Errorf("Backoff for attempt %d was %s, expected between %s and %s", attempt, delay, max/2, max)
+		}
+	}
+}
+
+func TestHeaderDelay(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	for _, test := range []struct {
+		header      http.Header
+		rateLimited bool
+		delay       time.Duration
+		ok          bool
+	}{
+		{http.Header{"Retry-After": {"7"}}, true, 7 * time.Second, true},
+		{http.Header{"Retry-After": {now.Add(3 * time.Second).UTC().Format(http.TimeFormat)}}, false, 3 * time.Second, true},
+		{http.Header{"X-Ratelimit-Reset": {"1500000010"}}, true, 10 * time.Second, true},
+		{http.Header{"X-Ratelimit-Reset": {"1400000000"}}, true, 0, true},
+		{http.Header{"X-Ratelimit-Reset": {"1500000010"}}, false, 0, false},
+		{http.Header{}, true, 0, false},
+	} {
+		delay, ok := headerDelay(test.header, now, test.rateLimited)
+		if delay != test.delay || ok != test.ok {
+			t.Errorf("Delay for %v was %s, %v", test.header, delay, ok)
+		}
+	}
+}
+
+func TestRetryServerErrorIgnoresRateLimitReset(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	retry := RetryOptions{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Hour}
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{"X-Ratelimit-Reset": {"1500000600"}}}
+	delay, ok := retry.delay(RetryAttempt{Attempt: 1, Method: "GET", Response: resp, Now: now})
+	if !ok || delay > time.Second {
+		t.Errorf("Delay for a 503 was %s, %v, expected backoff rather than X-RateLimit-Reset", delay, ok)
+	}
+}
+
+type conflictRetryPolicy struct {
+	RetryOptions
+	attempts []RetryAttempt
+}
+
+func (p *conflictRetryPolicy) RetryAfter(attempt RetryAttempt) (time.Duration, bool) {
+	p.attempts = append(p.attempts, attempt)
+	if attempt.Response != nil && attempt.Response.StatusCode == http.StatusConflict && attempt.Path == "/tags" && attempt.Attempt < 3 {
+		return time.Millisecond, true
+	}
+	return p.RetryOptions.RetryAfter(attempt)
+}
+
+func TestRetryPolicy(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/tags" && requests < 3 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		if r.URL.Path == "/events" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Retry = &RetryOptions{MaxAttempts: 5}
+	var policy RetryPolicy = &conflictRetryPolicy{RetryOptions: RetryOptions{MaxAttempts: 2, BaseDelay: time.Millisecond}}
+	client.RetryPolicy = &policy
+	if _, err := client.Post("/tags", map[string]string{"name": "vip"}); err != nil || requests != 3 {
+		t.Errorf("Expected the 409s to be retried, got %v after %d requests", err, requests)
+	}
+	attempts := policy.(*conflictRetryPolicy).attempts
+	if len(attempts) != 3 || attempts[0].Attempt != 1 || attempts[0].Method != "POST" || attempts[2].Response.StatusCode != 200 {
+		t.Errorf("Policy was asked about %+v", attempts)
+	}
+
+	requests = 0
+	if _, err := client.Get("/events", nil); err == nil || requests != 2 {
+		t.Errorf("Expected the policy's options to be delegated to, got %v after %d requests", err, requests)
+	}
+}
@@ -0,0 +1,98 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// HTTPStatsClient is a HTTPClient which keeps count of what it has done.
+type HTTPStatsClient interface {
+	HTTPClient
+	Stats() Stats
+}
+
+// Stats are counts of what a HTTPClient has done since it was created.
+type Stats struct {
+	// Requests is the number of requests made, not counting retries.
+	Requests int64
+
+	// InFlight is the number of requests which haven't finished, including reading their response.
+	InFlight int64
+
+	// Retries is the number of times requests were retried.
+	Retries int64
+
+	// RateLimited is the number of responses which were rate limited, with a 429.
+	RateLimited int64
+
+	// BytesRead is the number of bytes of response bodies read, as they were sent, so before decompression.
+	BytesRead int64
+}
+
+// StatsRecorder keeps Stats with atomic counters. It is safe for concurrent use.
+type StatsRecorder struct {
+	requests    int64
+	inFlight    int64
+	retries     int64
+	rateLimited int64
+	bytesRead   int64
+}
+
+// Stats returns a snapshot of the Stats.
+func (r *StatsRecorder) Stats() Stats {
+	if r == nil {
+		return Stats{}
+	}
+	return Stats{
+		Requests:    atomic.LoadInt64(&r.requests),
+		InFlight:    atomic.LoadInt64(&r.inFlight),
+		Retries:     atomic.LoadInt64(&r.retries),
+		RateLimited: atomic.LoadInt64(&r.rateLimited),
+		BytesRead:   atomic.LoadInt64(&r.bytesRead),
+	}
+}
+
+// start counts a request as in flight until cancel, which it returns a wrapper of, is first called.
+func (r *StatsRecorder) start(cancel context.CancelFunc) context.CancelFunc {
+	if r == nil {
+		return cancel
+	}
+	atomic.AddInt64(&r.requests, 1)
+	atomic.AddInt64(&r.inFlight, 1)
+	var once sync.Once
+	return func() {
+		cancel()
+		once.Do(func() { atomic.AddInt64(&r.inFlight, -1) })
+	}
+}
+
+func (r *StatsRecorder) retry() {
+	if r != nil {
+		atomic.AddInt64(&r.retries, 1)
+	}
+}
+
+// response counts a response, and the bytes read from its body.
+func (r *StatsRecorder) response(resp *http.Response) {
+	if r == nil {
+		return
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		atomic.AddInt64(&r.rateLimited, 1)
+	}
+	resp.Body = countingBody{ReadCloser: resp.Body, count: &r.bytesRead}
+}
+
+type countingBody struct {
+	io.ReadCloser
+	count *int64
+}
+
+func (b countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	atomic.AddInt64(b.count, int64(n))
+	return n, err
+}
@@ -0,0 +1,61 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"type":"error.list","errors":[]}`))
+			return
+		}
+		w.Write([]byte(`{"type":"user.list","users":[]}`))
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Retry = &RetryOptions{MaxAttempts: 2, BaseDelay: time.Millisecond}
+	if _, err := client.Get("/users", nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := Stats{Requests: 1, Retries: 1, RateLimited: 1, BytesRead: int64(len(`{"type":"error.list","errors":[]}`) + len(`{"type":"user.list","users":[]}`))}
+	if stats := client.Stats(); stats != expected {
+		t.Errorf("Stats were %+v, expected %+v", stats, expected)
+	}
+}
+
+func TestStatsInFlight(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("export data"))
+	}))
+	defer server.Close()
+
+	client := newTestIntercomHTTPClient(server.URL)
+	body, err := client.GetStream("/download/content/data/1", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if inFlight := client.Stats().InFlight; inFlight != 1 {
+		t.Errorf("%d requests were in flight, expected the stream's", inFlight)
+	}
+	body.Close()
+	body.Close()
+	if inFlight := client.Stats().InFlight; inFlight != 0 {
+		t.Errorf("%d requests were in flight, expected none once closed", inFlight)
+	}
+}
+
+func TestStatsNil(t *testing.T) {
+	var recorder *StatsRecorder
+	if stats := recorder.Stats(); stats != (Stats{}) {
+		t.Errorf("Stats were %+v, expected none", stats)
+	}
+}
@@ -0,0 +1,94 @@
+package interfaces
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Throttle limits the rate of requests with a token bucket, holding up to burst tokens
+// and refilling at requestsPerMinute. It is safe for concurrent use, and off until its rate is set.
+type Throttle struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    int
+	tokens   float64
+	last     time.Time // zero until the first request after the rate is set
+}
+
+// NewThrottle returns a Throttle allowing requestsPerMinute, in bursts of up to burst requests.
+func NewThrottle(requestsPerMinute, burst int) *Throttle {
+	t := &Throttle{}
+	t.SetRate(requestsPerMinute, burst)
+	return t
+}
+
+// SetRate changes the rate of the Throttle. A requestsPerMinute of 0 turns it off.
+func (t *Throttle) SetRate(requestsPerMinute, burst int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if burst < 1 {
+		burst = 1
+	}
+	t.interval = 0
+	if requestsPerMinute > 0 {
+		t.interval = time.Minute / time.Duration(requestsPerMinute)
+	}
+	t.burst = burst
+	t.tokens = float64(burst)
+	t.last = time.Time{}
+}
+
+// Rate returns the requestsPerMinute and burst of the Throttle.
+func (t *Throttle) Rate() (requestsPerMinute, burst int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.interval == 0 {
+		return 0, t.burst
+	}
+	return int(time.Minute / t.interval), t.burst
+}
+
+// Wait blocks until a request may be made, or the context is done.
+func (t *Throttle) Wait(ctx context.Context) error {
+	return t.wait(ctx, RealClock{})
+}
+
+func (t *Throttle) wait(ctx context.Context, clock Clock) error {
+	if t == nil {
+		return nil
+	}
+	for {
+		wait := t.take(clock.Now())
+		if wait == 0 {
+			return nil
+		}
+		if err := sleep(ctx, clock, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// take takes a token if there is one, otherwise returning how long until there will be.
+func (t *Throttle) take(now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.interval == 0 {
+		return 0
+	}
+	if t.last.IsZero() {
+		t.last = now
+	}
+	if elapsed := now.Sub(t.last); elapsed > 0 {
+		t.tokens += float64(elapsed) / float64(t.interval)
+	}
+	if t.tokens > float64(t.burst) {
+		t.tokens = float64(t.burst)
+	}
+	t.last = now
+	if t.tokens >= 1 {
+		t.tokens--
+		return 0
+	}
+	return time.Duration((1 - t.tokens) * float64(t.interval))
+}
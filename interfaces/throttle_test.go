@@ -0,0 +1,93 @@
+package interfaces
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestThrottleTake(t *testing.T) {
+	throttle := NewThrottle(60, 2)
+	now := time.Unix(1500000000, 0)
+	if wait := throttle.take(now); wait != 0 {
+		t.Errorf("Expected the first request not to wait, got %s", wait)
+	}
+	if wait := throttle.take(now); wait != 0 {
+		t.Errorf("Expected the second request to burst, got %s", wait)
+	}
+	if wait := throttle.take(now); wait != time.Second {
+		t.Errorf("Expected the third request to wait 1s, got %s", wait)
+	}
+	if wait := throttle.take(now.Add(time.Second)); wait != 0 {
+		t.Errorf("Expected a token after 1s, got %s", wait)
+	}
+}
+
+// steppingClock is a Clock whose time only moves on when it's waited on, by the time waited.
+type steppingClock struct {
+	now   time.Time
+	waits []time.Duration
+}
+
+func (c *steppingClock) Now() time.Time {
+	return c.now
+}
+
+func (c *steppingClock) After(d time.Duration) <-chan time.Time {
+	c.now = c.now.Add(d)
+	c.waits = append(c.waits, d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func TestThrottleClock(t *testing.T) {
+	throttle := NewThrottle(60, 2)
+	clock := &steppingClock{now: time.Unix(1500000000, 0)}
+	for i := 0; i < 3; i++ {
+		if err := throttle.wait(context.Background(), clock); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if len(clock.waits) != 1 || clock.waits[0] != time.Second || !throttle.last.Equal(clock.now) {
+		t.Errorf("Expected a single wait of 1s, by the clock alone, got %v, with the throttle last at %s", clock.waits, throttle.last)
+	}
+
+	throttle.SetRate(60, 1)
+	clock.now = clock.now.Add(time.Hour)
+	if err := throttle.wait(context.Background(), clock); err != nil || len(clock.waits) != 1 || !throttle.last.Equal(clock.now) {
+		t.Errorf("Expected the new rate's first request not to wait, got %v, %v", err, clock.waits)
+	}
+}
+
+func TestThrottleOff(t *testing.T) {
+	throttle := &Throttle{}
+	for i := 0; i < 100; i++ {
+		if wait := throttle.take(time.Now()); wait != 0 {
+			t.Fatalf("Expected no wait, got %s", wait)
+		}
+	}
+	var none *Throttle
+	if err := none.Wait(context.Background()); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestThrottleWaitCancelled(t *testing.T) {
+	throttle := NewThrottle(1, 1)
+	if err := throttle.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := throttle.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected the wait to be cut short, got %v", err)
+	}
+}
+
+func TestThrottleRate(t *testing.T) {
+	throttle := NewThrottle(120, 5)
+	if rate, burst := throttle.Rate(); rate != 120 || burst != 5 {
+		t.Errorf("Rate was %d, %d", rate, burst)
+	}
+}
@@ -0,0 +1,94 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// ErrTimeout is matched by TimeoutErrors with errors.Is.
+var ErrTimeout = errors.New("Request Timed Out")
+
+// HTTPTimeoutClient is a HTTPClient which can make its requests with a timeout.
+type HTTPTimeoutClient interface {
+	HTTPClient
+	WithTimeout(time.Duration) HTTPClient
+}
+
+// TimeoutError is returned for a request which timed out, including any retries.
+// It matches ErrTimeout with errors.Is, and unwraps to the error from the transport or context.
+type TimeoutError struct {
+	// After is the request timeout, which is 0 if the request timed out for another reason,
+	// such as its context's deadline.
+	After time.Duration
+	Err   error
+}
+
+func (e TimeoutError) Error() string {
+	if e.After > 0 {
+		return fmt.Sprintf("%s after %s: %v", ErrTimeout, e.After, e.Err)
+	}
+	return fmt.Sprintf("%s: %v", ErrTimeout, e.Err)
+}
+
+// Timeout is always true, as for a net.Error.
+func (e TimeoutError) Timeout() bool {
+	return true
+}
+
+func (e TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+func (e TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// WithTimeout returns a copy of the IntercomHTTPClient whose requests time out after timeout,
+// overriding its default Timeout.
+func (c IntercomHTTPClient) WithTimeout(timeout time.Duration) HTTPClient {
+	c.timeout = timeout
+	return c
+}
+
+func (c IntercomHTTPClient) requestTimeout() time.Duration {
+	if c.timeout > 0 {
+		return c.timeout
+	}
+	if c.Timeout != nil {
+		return *c.Timeout
+	}
+	return 0
+}
+
+// timeoutContext is the context for a request, which is done when the request times out.
+func (c IntercomHTTPClient) timeoutContext() (context.Context, context.CancelFunc) {
+	if timeout := c.requestTimeout(); timeout > 0 {
+		return context.WithTimeout(c.context(), timeout)
+	}
+	return context.WithCancel(c.context())
+}
+
+// timeoutError wraps err in a TimeoutError, if it is from a timeout.
+func (c IntercomHTTPClient) timeoutError(err error) error {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return TimeoutError{After: c.requestTimeout(), Err: err}
+	}
+	return err
+}
+
+// cancelBody cancels the context of a request once its response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
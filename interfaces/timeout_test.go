@@ -0,0 +1,54 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRequestTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	defer close(done)
+
+	timeout := 10 * time.Millisecond
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Timeout = &timeout
+	_, err := client.Get("/users", nil)
+	var timeoutErr TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.After != timeout {
+		t.Fatalf("Expected a TimeoutError, got %v", err)
+	}
+	if !errors.Is(err, ErrTimeout) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected ErrTimeout and context.DeadlineExceeded, got %v", err)
+	}
+
+	_, err = client.WithTimeout(time.Millisecond).(IntercomHTTPClient).Post("/users", nil)
+	if !errors.As(err, &timeoutErr) || timeoutErr.After != time.Millisecond {
+		t.Errorf("Expected the timeout to be overridden, got %v", err)
+	}
+}
+
+func TestRequestTimeoutNotReached(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer server.Close()
+
+	timeout := time.Second
+	client := newTestIntercomHTTPClient(server.URL)
+	client.Timeout = &timeout
+	if data, err := client.Get("/users", nil); err != nil || string(data) != `{"id":"1"}` {
+		t.Errorf("Got %s, %v", data, err)
+	}
+}
@@ -1,10 +1,18 @@
 package intercom
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
 
 // JobService builds jobs to process
 type JobService struct {
 	Repository JobRepository
+
+	clock interfaces.Clock
 }
 
 // The state of a Job
@@ -41,8 +49,38 @@ type JobResponse struct {
 	CompletedAt int64             `json:"completed_at,omitempty"`
 	ClosingAt   int64             `json:"closing_at,omitempty"`
 	Name        string            `json:"name,omitempty"`
-	State       string            `json:"job_state,omitempty"`
+	State       string            `json:"state,omitempty"`
 	Links       map[string]string `json:"links,omitempty"`
+	Tasks       []JobTask         `json:"tasks,omitempty"`
+}
+
+// A JobTask is a batch of items within a Job, processed together.
+type JobTask struct {
+	ID          string `json:"id,omitempty"`
+	ItemCount   int    `json:"item_count,omitempty"`
+	CreatedAt   int64  `json:"created_at,omitempty"`
+	StartedAt   int64  `json:"started_at,omitempty"`
+	CompletedAt int64  `json:"completed_at,omitempty"`
+	State       string `json:"state,omitempty"`
+}
+
+// JobStats counts the items of a Job by the state of the task they belong to.
+type JobStats struct {
+	Queued    int
+	Running   int
+	Succeeded int
+	Failed    int
+}
+
+// JobFailedError is returned by Wait when a Job finishes with failures.
+type JobFailedError struct {
+	ID    string
+	Stats JobStats
+}
+
+func (e JobFailedError) Error() string {
+	total := e.Stats.Queued + e.Stats.Running + e.Stats.Succeeded + e.Stats.Failed
+	return fmt.Sprintf("Job %s Failed: %d of %d items failed", e.ID, e.Stats.Failed, total)
 }
 
 // JobData is a payload that can be used to identify an existing Job to append to.
@@ -64,21 +102,104 @@ func (js *JobService) NewEventJob(items ...*JobItem) (JobResponse, error) {
 
 // Append User items to existing Job
 func (js *JobService) AppendUsers(id string, items ...*JobItem) (JobResponse, error) {
+	if id == "" {
+		return JobResponse{}, missing("Job ID")
+	}
 	job := JobRequest{JobData: &JobData{ID: id}, Items: items, bulkType: "users"}
 	return js.Repository.save(&job)
 }
 
 // Append Event items to existing Job
 func (js *JobService) AppendEvents(id string, items ...*JobItem) (JobResponse, error) {
+	if id == "" {
+		return JobResponse{}, missing("Job ID")
+	}
 	job := JobRequest{JobData: &JobData{ID: id}, Items: items, bulkType: "events"}
 	return js.Repository.save(&job)
 }
 
 // Find existing Job
 func (js *JobService) Find(id string) (JobResponse, error) {
+	if id == "" {
+		return JobResponse{}, missing("Job ID")
+	}
 	return js.Repository.find(id)
 }
 
+// The longest Wait backs off for after rate limited polls, unless the rate limit says to wait longer.
+const maxJobPollBackoff = time.Minute
+
+// Wait polls a Job every pollInterval until it completes or fails, or the context is done.
+// Polls which are rate limited wait as long as the RateLimitError says, or else back off, before trying again.
+// A JobFailedError is returned if the Job failed, or any of its tasks did.
+func (js *JobService) Wait(ctx context.Context, id string, pollInterval time.Duration) (JobResponse, error) {
+	if id == "" {
+		return JobResponse{}, missing("Job ID")
+	}
+	if pollInterval <= 0 {
+		return JobResponse{}, ArgumentError{Message: "Poll Interval Must Be Positive"}
+	}
+	clock := clockOrReal(js.clock)
+	repository := js.repositoryWithContext(ctx)
+	wait := pollInterval
+	for {
+		job, err := repository.find(id)
+		if err != nil {
+			var rateLimited bool
+			if wait, rateLimited = rateLimitBackoff(err, clock.Now(), wait, maxJobPollBackoff); !rateLimited {
+				return job, err
+			}
+		} else {
+			wait = pollInterval
+			if job.Done() {
+				if stats := job.Stats(); job.State == FAILED.String() || stats.Failed > 0 {
+					return job, JobFailedError{ID: job.ID, Stats: stats}
+				}
+				return job, nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-clock.After(wait):
+		}
+	}
+}
+
+// repositoryWithContext returns the Repository making its requests with ctx, when it's a JobAPI
+// whose HTTPClient can, so that a poll is cancelled along with ctx too.
+func (js *JobService) repositoryWithContext(ctx context.Context) JobRepository {
+	if api, ok := js.Repository.(JobAPI); ok {
+		if httpClient, ok := currentHTTPClient(api.httpClient).(interfaces.HTTPContextClient); ok {
+			return JobAPI{httpClient: httpClient.WithContext(ctx)}
+		}
+	}
+	return js.Repository
+}
+
+// Done reports whether the Job has reached a terminal state.
+func (j JobResponse) Done() bool {
+	return j.State == COMPLETED.String() || j.State == FAILED.String()
+}
+
+// Stats counts the items of the Job by the state of their task.
+func (j JobResponse) Stats() JobStats {
+	stats := JobStats{}
+	for _, task := range j.Tasks {
+		switch task.State {
+		case RUNNING.String():
+			stats.Running += task.ItemCount
+		case COMPLETED.String():
+			stats.Succeeded += task.ItemCount
+		case FAILED.String():
+			stats.Failed += task.ItemCount
+		default:
+			stats.Queued += task.ItemCount
+		}
+	}
+	return stats
+}
+
 func (j JobResponse) String() string {
 	return fmt.Sprintf("[intercom] job { id: %s, name: %s}", j.ID, j.Name)
 }
@@ -1,7 +1,6 @@
 package intercom
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"gopkg.in/intercom/intercom-go.v2/interfaces"
@@ -25,23 +24,27 @@ func (api JobAPI) save(job *JobRequest) (JobResponse, error) {
 		case *User:
 			user := obj.(*User)
 			job.Items[i].Data = RequestUserMapper{}.ConvertUser(user)
+		case *Event:
+			job.Items[i].Data = buildRequestEvent(obj.(*Event))
 		}
 	}
 	savedJob := JobResponse{}
-	data, err := api.httpClient.Post(fmt.Sprintf("/bulk/%s", job.bulkType), job)
+	uri := fmt.Sprintf("/bulk/%s", job.bulkType)
+	data, err := api.httpClient.Post(uri, job)
 	if err != nil {
 		return savedJob, err
 	}
-	err = json.Unmarshal(data, &savedJob)
+	err = unmarshalAccepted(uri, data, &savedJob)
 	return savedJob, err
 }
 
 func (api JobAPI) find(id string) (JobResponse, error) {
 	fetchedJob := JobResponse{}
-	data, err := api.httpClient.Get(fmt.Sprintf("/jobs/%s", id), nil)
+	uri := fmt.Sprintf("/jobs/%s", id)
+	data, err := api.httpClient.Get(uri, nil)
 	if err != nil {
 		return fetchedJob, err
 	}
-	err = json.Unmarshal(data, &fetchedJob)
+	err = unmarshal(uri, data, &fetchedJob)
 	return fetchedJob, err
 }
@@ -6,7 +6,7 @@ import (
 )
 
 func TestJobAPISaveUser(t *testing.T) {
-	http := TestJobHTTPClient{t: t, expectedURI: "/bulk/users", fixtureFilename: "fixtures/job.json"}
+	http := TestJobHTTPClient{t: t, expectedURI: "/bulk/users", fixtureFilename: "intercomtest/fixtures/job.json"}
 	api := JobAPI{httpClient: &http}
 	user := User{UserID: "1234"}
 	job := JobRequest{Items: []*JobItem{NewUserJobItem(&user, JOB_POST)}, bulkType: "users"}
@@ -25,7 +25,7 @@ func TestJobAPISaveUser(t *testing.T) {
 }
 
 func TestJobAPISaveEvent(t *testing.T) {
-	http := TestJobHTTPClient{t: t, expectedURI: "/bulk/events", fixtureFilename: "fixtures/job.json"}
+	http := TestJobHTTPClient{t: t, expectedURI: "/bulk/events", fixtureFilename: "intercomtest/fixtures/job.json"}
 	api := JobAPI{httpClient: &http}
 	event := Event{UserID: "1234"}
 	job := JobRequest{Items: []*JobItem{NewEventJobItem(&event)}, bulkType: "events"}
@@ -33,7 +33,7 @@ func TestJobAPISaveEvent(t *testing.T) {
 		if job.Items[0].DataType != "event" {
 			t.Errorf("job item was of wrong data type, expected %s, was %s", "event", job.Items[0].DataType)
 		}
-		if job.Items[0].Data.(*Event).UserID != "1234" {
+		if job.Items[0].Data.(requestEvent).UserID != "1234" {
 			t.Errorf("wrong user id sent")
 		}
 	}
@@ -43,6 +43,21 @@ func TestJobAPISaveEvent(t *testing.T) {
 	}
 }
 
+func TestJobAPIFind(t *testing.T) {
+	http := TestJobHTTPClient{t: t, expectedURI: "/jobs/job_5ca1ab1eca11ab1e", fixtureFilename: "intercomtest/fixtures/job.json"}
+	api := JobAPI{httpClient: &http}
+	job, _ := api.find("job_5ca1ab1eca11ab1e")
+	if job.State != "running" {
+		t.Errorf("Job state was %s, expected running", job.State)
+	}
+	if len(job.Tasks) != 2 || job.Tasks[0].ItemCount != 2 {
+		t.Errorf("Job tasks not decoded: %+v", job.Tasks)
+	}
+	if stats := job.Stats(); stats.Succeeded != 2 || stats.Running != 3 {
+		t.Errorf("Job stats were %+v", stats)
+	}
+}
+
 type TestJobHTTPClient struct {
 	TestHTTPClient
 	t               *testing.T
@@ -60,3 +75,10 @@ func (t *TestJobHTTPClient) Post(uri string, body interface{}) ([]byte, error) {
 	}
 	return ioutil.ReadFile(t.fixtureFilename)
 }
+
+func (t *TestJobHTTPClient) Get(uri string, queryParams interface{}) ([]byte, error) {
+	if t.expectedURI != uri {
+		t.t.Errorf("Wrong endpoint called")
+	}
+	return ioutil.ReadFile(t.fixtureFilename)
+}
@@ -13,9 +13,9 @@ func NewUserJobItem(user *User, method JobItemMethod) *JobItem {
 	return &JobItem{Method: method.String(), DataType: "user", Data: user}
 }
 
-// NewEventJobItem creates a JobItem that holds an Event.
+// NewEventJobItem creates a JobItem that holds an Event, identified as it would be by EventService.Save.
 func NewEventJobItem(event *Event) *JobItem {
-	return &JobItem{Method: JOB_POST.String(), DataType: "event", Data: event}
+	return &JobItem{Method: JOB_POST.String(), DataType: "event", Data: buildRequestEvent(event)}
 }
 
 type JobItemMethod int
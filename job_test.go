@@ -1,6 +1,15 @@
 package intercom
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
 
 func TestNewJob(t *testing.T) {
 	repo := &TestJobRepository{t: t}
@@ -37,18 +46,161 @@ func TestAppendJob(t *testing.T) {
 	js.AppendUsers(newJob.ID, NewUserJobItem(&user, JOB_POST))
 }
 
+func TestWaitJob(t *testing.T) {
+	repo := &TestJobRepository{t: t, polls: []JobResponse{
+		{ID: "job_5ca1ab1eca11ab1e", State: "running"},
+		{ID: "job_5ca1ab1eca11ab1e", State: "completed", Tasks: []JobTask{{ItemCount: 2, State: "completed"}}},
+	}}
+	js := JobService{Repository: repo}
+	job, err := js.Wait(context.Background(), "job_5ca1ab1eca11ab1e", time.Millisecond)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if job.State != "completed" || repo.findCount != 2 {
+		t.Errorf("Job was %s after %d polls", job.State, repo.findCount)
+	}
+}
+
+func TestWaitJobRateLimited(t *testing.T) {
+	repo := &TestJobRepository{t: t, polls: []JobResponse{
+		{ID: "job_5ca1ab1eca11ab1e", State: "completed"},
+	}, errs: []error{interfaces.HTTPError{StatusCode: 429, Code: "rate_limit_exceeded"}}}
+	js := JobService{Repository: repo}
+	if _, err := js.Wait(context.Background(), "job_5ca1ab1eca11ab1e", time.Millisecond); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if repo.findCount != 2 {
+		t.Errorf("Expected 2 polls, got %d", repo.findCount)
+	}
+}
+
+func TestWaitJobRateLimitedBackoff(t *testing.T) {
+	limited := interfaces.HTTPError{StatusCode: 429, Code: "rate_limit_exceeded"}
+	repo := &TestJobRepository{t: t, polls: []JobResponse{
+		{ID: "job_5ca1ab1eca11ab1e", State: "completed"},
+	}, errs: []error{
+		limited, limited, limited,
+		interfaces.RateLimitError{HTTPError: limited, RetryAfter: "7"},
+	}}
+	clock := &testClock{now: time.Unix(1500000000, 0)}
+	js := JobService{Repository: repo, clock: clock}
+	if _, err := js.Wait(context.Background(), "job_5ca1ab1eca11ab1e", 20*time.Second); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := []time.Duration{40 * time.Second, maxJobPollBackoff, maxJobPollBackoff, 7 * time.Second}
+	if len(clock.waits) != len(expected) {
+		t.Fatalf("Waits were %v, expected %v", clock.waits, expected)
+	}
+	for i, wait := range expected {
+		if clock.waits[i] != wait {
+			t.Errorf("Waits were %v, expected %v", clock.waits, expected)
+		}
+	}
+}
+
+func TestWaitJobInvalid(t *testing.T) {
+	repo := &TestJobRepository{t: t}
+	js := JobService{Repository: repo}
+	if _, err := js.Wait(context.Background(), "", time.Second); !errors.As(err, &ArgumentError{}) {
+		t.Errorf("Expected an ArgumentError for a missing ID, got %v", err)
+	}
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if _, err := js.Wait(context.Background(), "job_5ca1ab1eca11ab1e", interval); !errors.As(err, &ArgumentError{}) {
+			t.Errorf("Expected an ArgumentError for a poll interval of %s, got %v", interval, err)
+		}
+	}
+	if repo.findCount != 0 {
+		t.Errorf("Expected no polls, got %d", repo.findCount)
+	}
+}
+
+func TestWaitJobCancelledPoll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	ic := NewClient("app_id", "api_key", BaseURI(server.URL))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		_, err := ic.Jobs.Wait(ctx, "job_5ca1ab1eca11ab1e", time.Hour)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Errorf("Expected the poll to fail when the context was done")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the poll to be cancelled along with the context")
+	}
+}
+
+func TestWaitJobFailed(t *testing.T) {
+	repo := &TestJobRepository{t: t, polls: []JobResponse{
+		{ID: "job_5ca1ab1eca11ab1e", State: "failed", Tasks: []JobTask{{ItemCount: 2, State: "completed"}, {ItemCount: 3, State: "failed"}}},
+	}}
+	js := JobService{Repository: repo}
+	_, err := js.Wait(context.Background(), "job_5ca1ab1eca11ab1e", time.Millisecond)
+	failed, ok := err.(JobFailedError)
+	if !ok {
+		t.Fatalf("Expected JobFailedError, got %v", err)
+	}
+	if failed.Stats.Failed != 3 || failed.Stats.Succeeded != 2 {
+		t.Errorf("Stats were %+v", failed.Stats)
+	}
+	if failed.Error() != "Job job_5ca1ab1eca11ab1e Failed: 3 of 5 items failed" {
+		t.Errorf("Error was %s", failed.Error())
+	}
+}
+
+func TestWaitJobCancelled(t *testing.T) {
+	repo := &TestJobRepository{t: t, polls: []JobResponse{{ID: "job_5ca1ab1eca11ab1e", State: "running"}}}
+	js := JobService{Repository: repo}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := js.Wait(ctx, "job_5ca1ab1eca11ab1e", time.Hour); err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
 type TestJobRepository struct {
-	t *testing.T
-	f func(job *JobRequest)
+	t         *testing.T
+	f         func(job *JobRequest)
+	polls     []JobResponse
+	errs      []error
+	findCount int
+	saveErrs  []error
 }
 
 func (api *TestJobRepository) save(job *JobRequest) (JobResponse, error) {
 	if api.f != nil {
 		api.f(job)
 	}
-	return JobResponse{}, nil
+	if len(api.saveErrs) > 0 {
+		err := api.saveErrs[0]
+		api.saveErrs = api.saveErrs[1:]
+		if err != nil {
+			return JobResponse{}, err
+		}
+	}
+	return JobResponse{ID: "job_5ca1ab1eca11ab1e"}, nil
 }
 
 func (api *TestJobRepository) find(id string) (JobResponse, error) {
-	return JobResponse{}, nil
+	api.findCount++
+	if len(api.errs) > 0 {
+		err := api.errs[0]
+		api.errs = api.errs[1:]
+		return JobResponse{}, err
+	}
+	if len(api.polls) == 0 {
+		return JobResponse{}, nil
+	}
+	job := api.polls[0]
+	if len(api.polls) > 1 {
+		api.polls = api.polls[1:]
+	}
+	return job, nil
 }
@@ -1,13 +1,19 @@
 package intercom
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
 )
 
 // MessageService handles interactions with the API through an MessageRepository.
 type MessageService struct {
-	Repository MessageRepository
+	Repository             MessageRepository
+	ConversationRepository ConversationRepository
+
+	clock interfaces.Clock
 }
 
 // MessageTemplate determines the template used for email messages to Users or Contacts (plain or personal)
@@ -56,24 +62,57 @@ type MessageRequest struct {
 
 // MessageResponse represents a Message to be sent through Intercom from/to an Admin, User, or Contact.
 type MessageResponse struct {
-	MessageType string          `json:"message_type,omitempty"`
-	ID          string          `json:"id"`
-	CreatedAt   int64           `json:"created_at,omitempty"`
-	Owner       MessageAddress  `json:"owner,omitempty"`
-	Subject     string          `json:"subject,omitempty"`
-	Body        string          `json:"body,omitempty"`
-	Template    MessageTemplate `json:"template,omitempty"`
+	MessageType    string          `json:"message_type,omitempty"`
+	ID             string          `json:"id"`
+	CreatedAt      int64           `json:"created_at,omitempty"`
+	Owner          MessageAddress  `json:"owner,omitempty"`
+	Subject        string          `json:"subject,omitempty"`
+	Body           string          `json:"body,omitempty"`
+	Template       MessageTemplate `json:"template,omitempty"`
+	ConversationID string          `json:"conversation_id,omitempty"`
 }
 
 func (m MessageResponse) String() string {
 	return fmt.Sprintf("[intercom] message { id: %s, message_type: %s, body: %s }", m.ID, m.MessageType, m.Body)
 }
 
-// Save (send) a Message
+// Save (send) a Message.
+// Messages from an Admin must be addressed to a User or Contact (lead),
+// and a Template and Subject may only be set on email Messages.
 func (m *MessageService) Save(message *MessageRequest) (MessageResponse, error) {
+	if err := message.validate(); err != nil {
+		return MessageResponse{}, err
+	}
 	return m.Repository.save(message)
 }
 
+// Conversation finds the Conversation started by a saved Message.
+func (m *MessageService) Conversation(message MessageResponse) (Conversation, error) {
+	if message.ConversationID == "" {
+		return Conversation{}, errors.New("Message has no Conversation")
+	}
+	return m.ConversationRepository.Find(message.ConversationID)
+}
+
+func (m *MessageRequest) validate() error {
+	if m.From.Type == "admin" && (m.To.Type == "" || (m.To.ID == "" && m.To.UserID == "" && m.To.Email == "")) {
+		return missing("Message Recipient")
+	}
+	if m.MessageType == "email" {
+		if m.Template != "" && m.Template != PERSONAL_TEMPLATE.String() && m.Template != PLAIN_TEMPLATE.String() {
+			return fmt.Errorf("Unknown Message Template %s", m.Template)
+		}
+		return nil
+	}
+	if m.Template != "" {
+		return errors.New("Template is only valid for email Messages")
+	}
+	if m.Subject != "" {
+		return errors.New("Subject is only valid for email Messages")
+	}
+	return nil
+}
+
 // NewEmailMessage creates a new *Message of email type.
 func NewEmailMessage(template MessageTemplate, from, to MessagePerson, subject, body string) MessageRequest {
 	return MessageRequest{MessageType: "email", Template: template.String(), From: from.MessageAddress(), To: to.MessageAddress(), Subject: subject, Body: body}
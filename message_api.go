@@ -1,8 +1,6 @@
 package intercom
 
 import (
-	"encoding/json"
-
 	"gopkg.in/intercom/intercom-go.v2/interfaces"
 )
 
@@ -22,6 +20,6 @@ func (api MessageAPI) save(message *MessageRequest) (MessageResponse, error) {
 	if err != nil {
 		return savedMessage, err
 	}
-	err = json.Unmarshal(data, &savedMessage)
+	err = unmarshal("/messages", data, &savedMessage)
 	return savedMessage, err
 }
@@ -6,7 +6,7 @@ import (
 )
 
 func TestMessageAPISave(t *testing.T) {
-	http := TestMessageHTTPClient{t: t, expectedURI: "/messages", fixtureFilename: "fixtures/message.json"}
+	http := TestMessageHTTPClient{t: t, expectedURI: "/messages", fixtureFilename: "intercomtest/fixtures/message.json"}
 	api := MessageAPI{httpClient: &http}
 	message := NewUserMessage(User{}, "Hey, is the new thing in stock?")
 	msg, err := api.save(&message)
@@ -22,6 +22,9 @@ func TestMessageAPISave(t *testing.T) {
 	if msg.Template != PERSONAL_TEMPLATE {
 		t.Errorf("Message template was not set, was %s", msg.Template)
 	}
+	if msg.ConversationID != "147" {
+		t.Errorf("Message ConversationID was not set, was %s", msg.ConversationID)
+	}
 }
 
 type TestMessageHTTPClient struct {
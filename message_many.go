@@ -0,0 +1,80 @@
+package intercom
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	messageBackoff    = time.Second
+	maxMessageBackoff = time.Minute
+)
+
+// SendToManyOptions configures MessageService.SendToMany. Zero values take the defaults given.
+type SendToManyOptions struct {
+	// Concurrency is the number of Messages sent at once. Defaults to 4.
+	Concurrency int
+	// MaxRetries is how many times a rate limited Message is retried, on top of any retries the Client makes. Defaults to 3.
+	MaxRetries int
+}
+
+// A MessageResult is the outcome of sending a Message to one recipient.
+type MessageResult struct {
+	Recipient User
+	Message   MessageResponse
+	Err       error
+}
+
+// SendToMany sends a copy of a Message to each recipient, concurrently.
+// Repeated recipients are sent to once, and rate limited Messages are retried, waiting as long as
+// Retry-After says, or else with exponential backoff.
+// A MessageResult is returned for each distinct recipient, in order.
+func (m *MessageService) SendToMany(recipients []User, message MessageRequest, opts SendToManyOptions) []MessageResult {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	if opts.MaxRetries <= 0 {
+		opts.MaxRetries = 3
+	}
+	results := []MessageResult{}
+	seen := map[MessageAddress]bool{}
+	for _, recipient := range recipients {
+		address := recipient.MessageAddress()
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		results = append(results, MessageResult{Recipient: recipient})
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, opts.Concurrency)
+	for i := range results {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *MessageResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			request := message
+			request.To = result.Recipient.MessageAddress()
+			result.Message, result.Err = m.saveWithBackoff(&request, opts.MaxRetries)
+		}(&results[i])
+	}
+	wg.Wait()
+	return results
+}
+
+func (m *MessageService) saveWithBackoff(message *MessageRequest, maxRetries int) (MessageResponse, error) {
+	clock := clockOrReal(m.clock)
+	wait := messageBackoff / 2
+	for attempt := 0; ; attempt++ {
+		savedMessage, err := m.Save(message)
+		if err == nil || attempt >= maxRetries {
+			return savedMessage, err
+		}
+		var rateLimited bool
+		if wait, rateLimited = rateLimitBackoff(err, clock.Now(), wait, maxMessageBackoff); !rateLimited {
+			return savedMessage, err
+		}
+		<-clock.After(wait)
+	}
+}
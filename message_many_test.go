@@ -0,0 +1,65 @@
+package intercom
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+func TestSendToMany(t *testing.T) {
+	repo := &TestManyMessageAPI{rateLimited: map[string]int{"2": 2}, failing: map[string]bool{"3": true}}
+	clock := &testClock{now: time.Unix(1500000000, 0)}
+	messageService := MessageService{Repository: repo, clock: clock}
+	recipients := []User{User{ID: "1"}, User{ID: "2"}, User{ID: "1"}, User{ID: "3"}}
+	results := messageService.SendToMany(recipients, NewInAppMessage(Admin{ID: "123"}, User{}, "Incident resolved"), SendToManyOptions{})
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results for distinct recipients, got %d", len(results))
+	}
+	if repo.sent["1"] != 1 {
+		t.Errorf("Expected the repeated recipient to be sent once, was sent %d times", repo.sent["1"])
+	}
+	if results[0].Recipient.ID != "1" || results[0].Err != nil || results[0].Message.ID != "msg-1" {
+		t.Errorf("Expected message msg-1 for recipient 1, got %+v", results[0])
+	}
+	if results[1].Recipient.ID != "2" || results[1].Err != nil {
+		t.Errorf("Expected the rate limited recipient to be retried, got %+v", results[1])
+	}
+	if len(clock.waits) != 2 || clock.waits[0] != messageBackoff || clock.waits[1] != 30*time.Second {
+		t.Errorf("Expected the retries to back off by the clock, then wait as long as Retry-After said, got %v", clock.waits)
+	}
+	if results[2].Recipient.ID != "3" || results[2].Err == nil {
+		t.Errorf("Expected an error for recipient 3, got %+v", results[2])
+	}
+}
+
+type TestManyMessageAPI struct {
+	mu          sync.Mutex
+	sent        map[string]int
+	rateLimited map[string]int
+	failing     map[string]bool
+}
+
+func (t *TestManyMessageAPI) save(message *MessageRequest) (MessageResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id := message.To.ID
+	if t.rateLimited[id] > 0 {
+		t.rateLimited[id]--
+		rateLimited := interfaces.HTTPError{StatusCode: 429, Code: "rate_limit_exceeded"}
+		if t.rateLimited[id] == 0 {
+			return MessageResponse{}, RateLimitError{HTTPError: rateLimited, RetryAfter: "30"}
+		}
+		return MessageResponse{}, fmt.Errorf("sending message: %w", rateLimited)
+	}
+	if t.failing[id] {
+		return MessageResponse{}, interfaces.HTTPError{StatusCode: 404, Code: "not_found"}
+	}
+	if t.sent == nil {
+		t.sent = map[string]int{}
+	}
+	t.sent[id]++
+	return MessageResponse{ID: "msg-" + id, Body: message.Body}, nil
+}
@@ -1,6 +1,9 @@
 package intercom
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestNewEmailMessage(t *testing.T) {
 	user := User{}
@@ -75,18 +78,78 @@ func TestNewUserMessage(t *testing.T) {
 
 func TestSaveMessage(t *testing.T) {
 	messageService := MessageService{Repository: TestMessageAPI{t: t}}
-	message := NewInAppMessage(Admin{}, User{}, "hi there")
+	message := NewInAppMessage(Admin{}, User{ID: "46adad3f09126dca"}, "hi there")
 	resp, _ := messageService.Save(&message)
 	if resp.Owner.Type != "admin" {
 		t.Errorf("Owner was not admin")
 	}
 }
 
+func TestSaveEmailMessage(t *testing.T) {
+	messageService := MessageService{Repository: TestMessageAPI{t: t}}
+	message := NewEmailMessage(PLAIN_TEMPLATE, Admin{}, User{Email: "jamie@example.io"}, "subject", "body")
+	if _, err := messageService.Save(&message); err != nil {
+		t.Errorf("Expected email message with template and subject to be valid, got %v", err)
+	}
+	message.Template = "fancy"
+	if _, err := messageService.Save(&message); err == nil {
+		t.Errorf("Expected unknown template to be rejected")
+	}
+}
+
+func TestSaveInAppMessageWithEmailFields(t *testing.T) {
+	messageService := MessageService{Repository: TestMessageAPI{t: t}}
+	message := NewInAppMessage(Admin{}, User{UserID: "27"}, "hi there")
+	message.Subject = "subject"
+	if _, err := messageService.Save(&message); err == nil {
+		t.Errorf("Expected subject on inapp message to be rejected")
+	}
+	message = NewInAppMessage(Admin{}, User{UserID: "27"}, "hi there")
+	message.Template = PLAIN_TEMPLATE.String()
+	if _, err := messageService.Save(&message); err == nil {
+		t.Errorf("Expected template on inapp message to be rejected")
+	}
+}
+
+func TestMessageToLead(t *testing.T) {
+	message := NewInAppMessage(Admin{ID: "1"}, Contact{ID: "5811e1c5"}, "hi there")
+	b, _ := json.Marshal(message.To)
+	if string(b) != `{"type":"contact","id":"5811e1c5"}` {
+		t.Errorf("Lead recipient serialised as %s", b)
+	}
+}
+
+func TestSaveMessageMissingRecipient(t *testing.T) {
+	messageService := MessageService{Repository: TestMessageAPI{t: t}}
+	message := NewInAppMessage(Admin{ID: "1"}, Contact{}, "hi there")
+	if _, err := messageService.Save(&message); err == nil {
+		t.Errorf("Expected a message without a recipient to be rejected")
+	}
+	message = MessageRequest{MessageType: "inapp", From: Admin{ID: "1"}.MessageAddress(), Body: "hi there"}
+	if _, err := messageService.Save(&message); err == nil {
+		t.Errorf("Expected a message without a recipient to be rejected")
+	}
+}
+
+func TestMessageConversation(t *testing.T) {
+	messageService := MessageService{Repository: TestMessageAPI{t: t}, ConversationRepository: TestConversationAPI{t: t}}
+	convo, err := messageService.Conversation(MessageResponse{ID: "2001", ConversationID: "123"})
+	if err != nil || convo.ID != "123" {
+		t.Errorf("Expected conversation 123, got %s (%v)", convo.ID, err)
+	}
+	if _, err := messageService.Conversation(MessageResponse{ID: "2001"}); err == nil {
+		t.Errorf("Expected an error for a message without a conversation")
+	}
+}
+
 type TestMessageAPI struct {
 	t *testing.T
 }
 
 func (t TestMessageAPI) save(message *MessageRequest) (MessageResponse, error) {
+	if message.MessageType == "email" {
+		return MessageResponse{MessageType: message.MessageType, Owner: message.From, Body: message.Body}, nil
+	}
 	if message.MessageType != "inapp" {
 		t.t.Errorf("Message not inapp")
 	}
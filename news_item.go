@@ -0,0 +1,140 @@
+package intercom
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// News item states, for NewsItem.State.
+const (
+	NewsItemDraft = "draft"
+	NewsItemLive  = "live"
+)
+
+// NewsItemService handles interactions with the API through a NewsItemRepository.
+type NewsItemService struct {
+	Repository NewsItemRepository
+}
+
+// NewsItem represents a News item, an announcement published to Newsfeeds.
+type NewsItem struct {
+	Type                string               `json:"type,omitempty"`
+	ID                  string               `json:"id,omitempty"`
+	WorkspaceID         string               `json:"workspace_id,omitempty"`
+	Title               string               `json:"title,omitempty"`
+	Body                string               `json:"body,omitempty"`
+	SenderID            json.Number          `json:"sender_id,omitempty"`
+	State               string               `json:"state,omitempty"`
+	NewsfeedAssignments []NewsfeedAssignment `json:"newsfeed_assignments,omitempty"`
+	Labels              []string             `json:"labels,omitempty"`
+	CoverImageURL       string               `json:"cover_image_url,omitempty"`
+	Reactions           []string             `json:"reactions,omitempty"`
+	DeliverSilently     bool                 `json:"deliver_silently,omitempty"`
+	CreatedAt           int64                `json:"created_at,omitempty"`
+	UpdatedAt           int64                `json:"updated_at,omitempty"`
+}
+
+// NewsfeedAssignment publishes a NewsItem to a Newsfeed, from PublishedAt.
+type NewsfeedAssignment struct {
+	NewsfeedID  json.Number `json:"newsfeed_id"`
+	PublishedAt int64       `json:"published_at,omitempty"`
+}
+
+// NewsItemList holds a page of NewsItems and paging information
+type NewsItemList struct {
+	Pages      PageParams `json:"pages"`
+	TotalCount int64      `json:"total_count"`
+	NewsItems  []NewsItem `json:"data"`
+}
+
+// Newsfeed is a feed NewsItems are published to.
+type Newsfeed struct {
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// NewsfeedList holds a list of Newsfeeds
+type NewsfeedList struct {
+	Newsfeeds []Newsfeed `json:"data"`
+}
+
+// Find a NewsItem by its ID.
+func (n *NewsItemService) Find(id string) (NewsItem, error) {
+	if id == "" {
+		return NewsItem{}, missing("News Item ID")
+	}
+	return n.Repository.find(id)
+}
+
+// List a page of NewsItems.
+func (n *NewsItemService) List(params PageParams) (NewsItemList, error) {
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return NewsItemList{}, err
+	}
+	return n.Repository.list(params)
+}
+
+// Create a NewsItem, which needs a Title and SenderID.
+func (n *NewsItemService) Create(newsItem *NewsItem) (NewsItem, error) {
+	if err := newsItem.validate(); err != nil {
+		return NewsItem{}, err
+	}
+	return n.Repository.create(newsItem)
+}
+
+// Update a NewsItem. The API needs the Title and SenderID to be sent with every update.
+func (n *NewsItemService) Update(id string, newsItem *NewsItem) (NewsItem, error) {
+	if id == "" {
+		return NewsItem{}, missing("News Item ID")
+	}
+	if err := newsItem.validate(); err != nil {
+		return NewsItem{}, err
+	}
+	return n.Repository.update(id, newsItem)
+}
+
+// Delete a NewsItem by its ID.
+func (n *NewsItemService) Delete(id string) error {
+	if id == "" {
+		return missing("News Item ID")
+	}
+	return n.Repository.delete(id)
+}
+
+// ListNewsfeeds lists all Newsfeeds for the App.
+func (n *NewsItemService) ListNewsfeeds() (NewsfeedList, error) {
+	return n.Repository.listNewsfeeds()
+}
+
+// FindByName finds a Newsfeed in the NewsfeedList by its Name.
+// It is safe to call on a nil NewsfeedList.
+func (l *NewsfeedList) FindByName(name string) (Newsfeed, bool) {
+	if l == nil {
+		return Newsfeed{}, false
+	}
+	for _, newsfeed := range l.Newsfeeds {
+		if newsfeed.Name == name {
+			return newsfeed, true
+		}
+	}
+	return Newsfeed{}, false
+}
+
+func (n NewsItem) validate() error {
+	if n.Title == "" || n.SenderID == "" {
+		return missing("News Item Title or Sender")
+	}
+	switch n.State {
+	case "", NewsItemDraft, NewsItemLive:
+		return nil
+	}
+	return fmt.Errorf("Invalid News Item State %q", n.State)
+}
+
+func (n NewsItem) String() string {
+	return fmt.Sprintf("[intercom] news_item { id: %s, title: %s, state: %s }", n.ID, n.Title, n.State)
+}
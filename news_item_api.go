@@ -0,0 +1,104 @@
+package intercom
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// NewsItemRepository defines the interface for working with NewsItems through the API.
+type NewsItemRepository interface {
+	find(id string) (NewsItem, error)
+	list(params PageParams) (NewsItemList, error)
+	create(*NewsItem) (NewsItem, error)
+	update(id string, newsItem *NewsItem) (NewsItem, error)
+	delete(id string) error
+	listNewsfeeds() (NewsfeedList, error)
+}
+
+// NewsItemAPI implements NewsItemRepository
+type NewsItemAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+type requestNewsItem struct {
+	Title               string               `json:"title"`
+	Body                string               `json:"body,omitempty"`
+	SenderID            json.Number          `json:"sender_id"`
+	State               string               `json:"state,omitempty"`
+	DeliverSilently     bool                 `json:"deliver_silently,omitempty"`
+	Labels              []string             `json:"labels,omitempty"`
+	Reactions           []string             `json:"reactions,omitempty"`
+	NewsfeedAssignments []NewsfeedAssignment `json:"newsfeed_assignments,omitempty"`
+}
+
+func (api NewsItemAPI) find(id string) (NewsItem, error) {
+	uri := fmt.Sprintf("/news/news_items/%s", id)
+	data, err := api.httpClient.Get(uri, nil)
+	if err != nil {
+		return NewsItem{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api NewsItemAPI) list(params PageParams) (NewsItemList, error) {
+	newsItemList := NewsItemList{}
+	data, err := api.httpClient.Get("/news/news_items", params)
+	if err != nil {
+		return newsItemList, err
+	}
+	err = unmarshal("/news/news_items", data, &newsItemList)
+	return newsItemList, err
+}
+
+func (api NewsItemAPI) create(newsItem *NewsItem) (NewsItem, error) {
+	data, err := api.httpClient.Post("/news/news_items", buildRequestNewsItem(newsItem))
+	if err != nil {
+		return NewsItem{}, err
+	}
+	return api.unmarshal("/news/news_items", data)
+}
+
+func (api NewsItemAPI) update(id string, newsItem *NewsItem) (NewsItem, error) {
+	uri := fmt.Sprintf("/news/news_items/%s", id)
+	data, err := put(api.httpClient, uri, buildRequestNewsItem(newsItem))
+	if err != nil {
+		return NewsItem{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api NewsItemAPI) delete(id string) error {
+	_, err := api.httpClient.Delete(fmt.Sprintf("/news/news_items/%s", id), nil)
+	return err
+}
+
+func (api NewsItemAPI) listNewsfeeds() (NewsfeedList, error) {
+	newsfeedList := NewsfeedList{}
+	data, err := api.httpClient.Get("/news/newsfeeds", nil)
+	if err != nil {
+		return newsfeedList, err
+	}
+	err = unmarshal("/news/newsfeeds", data, &newsfeedList)
+	return newsfeedList, err
+}
+
+func (api NewsItemAPI) unmarshal(uri string, data []byte) (NewsItem, error) {
+	newsItem := NewsItem{}
+	err := unmarshal(uri, data, &newsItem)
+	return newsItem, err
+}
+
+func buildRequestNewsItem(newsItem *NewsItem) *requestNewsItem {
+	return &requestNewsItem{
+		Title:               newsItem.Title,
+		Body:                newsItem.Body,
+		SenderID:            newsItem.SenderID,
+		State:               newsItem.State,
+		DeliverSilently:     newsItem.DeliverSilently,
+		Labels:              newsItem.Labels,
+		Reactions:           newsItem.Reactions,
+		NewsfeedAssignments: newsItem.NewsfeedAssignments,
+	}
+}
@@ -0,0 +1,43 @@
+package intercom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAPIFindNewsItem(t *testing.T) {
+	http := TestTicketHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/news_item.json", expectedURI: "/news/news_items/33"}
+	api := NewsItemAPI{httpClient: &http}
+	newsItem, err := api.find("33")
+	if err != nil {
+		t.Fatalf("Error finding news item: %v", err)
+	}
+	if newsItem.SenderID != "991267834" || newsItem.State != NewsItemLive || len(newsItem.Labels) != 2 {
+		t.Errorf("News item was not parsed, got %v", newsItem)
+	}
+	if newsItem.NewsfeedAssignments[0].NewsfeedID != "53" {
+		t.Errorf("Newsfeed assignments were %v", newsItem.NewsfeedAssignments)
+	}
+}
+
+func TestAPIUpdateNewsItem(t *testing.T) {
+	http := TestTicketHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/news_item.json", expectedURI: "/news/news_items/33"}
+	api := NewsItemAPI{httpClient: &http}
+	api.update("33", &NewsItem{Title: "We have news", SenderID: "991267834", State: NewsItemDraft})
+	b, _ := json.Marshal(http.lastRequest)
+	if string(b) != `{"title":"We have news","sender_id":991267834,"state":"draft"}` {
+		t.Errorf("Request was %s", b)
+	}
+}
+
+func TestAPIListNewsfeeds(t *testing.T) {
+	http := TestTicketHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/newsfeeds.json", expectedURI: "/news/newsfeeds"}
+	api := NewsItemAPI{httpClient: &http}
+	newsfeedList, err := api.listNewsfeeds()
+	if err != nil {
+		t.Fatalf("Error listing newsfeeds: %v", err)
+	}
+	if newsfeed, ok := newsfeedList.FindByName("Engineering blog"); !ok || newsfeed.ID != "54" {
+		t.Errorf("Newsfeed was not found by name, got %v", newsfeed)
+	}
+}
@@ -0,0 +1,42 @@
+package intercom
+
+import "testing"
+
+func TestCreateNewsItemValidation(t *testing.T) {
+	newsItemService := NewsItemService{Repository: TestNewsItemAPI{}}
+	if _, err := newsItemService.Create(&NewsItem{Title: "We have news"}); err == nil {
+		t.Errorf("Expected an error for a missing sender")
+	}
+	if _, err := newsItemService.Create(&NewsItem{Title: "We have news", SenderID: "991267834", State: "published"}); err == nil {
+		t.Errorf("Expected an error for an invalid state")
+	}
+	if _, err := newsItemService.Create(&NewsItem{Title: "We have news", SenderID: "991267834", State: NewsItemLive}); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+type TestNewsItemAPI struct{}
+
+func (t TestNewsItemAPI) find(id string) (NewsItem, error) {
+	return NewsItem{ID: id}, nil
+}
+
+func (t TestNewsItemAPI) list(params PageParams) (NewsItemList, error) {
+	return NewsItemList{}, nil
+}
+
+func (t TestNewsItemAPI) create(newsItem *NewsItem) (NewsItem, error) {
+	return *newsItem, nil
+}
+
+func (t TestNewsItemAPI) update(id string, newsItem *NewsItem) (NewsItem, error) {
+	return *newsItem, nil
+}
+
+func (t TestNewsItemAPI) delete(id string) error {
+	return nil
+}
+
+func (t TestNewsItemAPI) listNewsfeeds() (NewsfeedList, error) {
+	return NewsfeedList{}, nil
+}
@@ -0,0 +1,129 @@
+package intercom
+
+import "fmt"
+
+// NoteService handles interactions with the API through a NoteRepository.
+type NoteService struct {
+	Repository NoteRepository
+}
+
+// NoteList holds a list of Notes and paging information
+type NoteList struct {
+	Pages      PageParams `json:"pages"`
+	TotalCount int64      `json:"total_count"`
+	Notes      []Note     `json:"notes"`
+}
+
+// A Note is a note left by an Admin on a User.
+type Note struct {
+	ID        string `json:"id"`
+	CreatedAt int64  `json:"created_at"`
+	Body      string `json:"body"`
+	Author    Admin  `json:"author"`
+	User      User   `json:"user"`
+}
+
+type requestNote struct {
+	AdminID string          `json:"admin_id,omitempty"`
+	Body    string          `json:"body"`
+	User    requestNoteUser `json:"user"`
+}
+
+type requestNoteUser struct {
+	ID     string `json:"id,omitempty"`
+	UserID string `json:"user_id,omitempty"`
+	Email  string `json:"email,omitempty"`
+}
+
+type noteListParams struct {
+	PageParams
+	IntercomUserID string `url:"intercom_user_id,omitempty"`
+	UserID         string `url:"user_id,omitempty"`
+	Email          string `url:"email,omitempty"`
+}
+
+// New creates a Note on a User, optionally authored by an Admin.
+// The User is identified by their ID, UserID or Email, in that order of preference.
+func (n *NoteService) New(user *User, author *Admin, body string) (Note, error) {
+	if user == nil {
+		return Note{}, missing("User")
+	}
+	note := requestNote{Body: body}
+	switch {
+	case user.ID != "":
+		note.User.ID = user.ID
+	case user.UserID != "":
+		note.User.UserID = user.UserID
+	case user.Email != "":
+		note.User.Email = user.Email
+	default:
+		return Note{}, missing("User Identifier")
+	}
+	if author != nil {
+		note.AdminID = author.ID.String()
+	}
+	return n.Repository.save(&note)
+}
+
+// List a page of Notes for a User.
+// The User is identified by their ID, UserID or Email, in that order of preference.
+func (n *NoteService) List(user *User, params PageParams) (NoteList, error) {
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return NoteList{}, err
+	}
+	listParams, err := newNoteListParams(user, params)
+	if err != nil {
+		return NoteList{}, err
+	}
+	return n.Repository.list(listParams)
+}
+
+// All returns a Pager over every Note for a User, starting at the page params.
+func (n *NoteService) All(user *User, params PageParams) *Pager[Note] {
+	return NewPager(params, func(params PageParams) ([]Note, PageParams, error) {
+		params, err := params.limit(MaxPerPage)
+		if err != nil {
+			return nil, PageParams{}, err
+		}
+		listParams, err := newNoteListParams(user, params)
+		if err != nil {
+			return nil, PageParams{}, err
+		}
+		noteList, err := n.Repository.list(listParams)
+		return noteList.Notes, noteList.Pages, err
+	})
+}
+
+// ListAll walks every page of Notes for a User, calling fn with each Note.
+// It stops at the first error, from the API or returned by fn.
+func (n *NoteService) ListAll(user *User, fn func(Note) error) error {
+	notes := n.All(user, PageParams{Page: 1})
+	for notes.Next() {
+		if err := fn(notes.Item()); err != nil {
+			return err
+		}
+	}
+	return notes.Err()
+}
+
+func newNoteListParams(user *User, params PageParams) (noteListParams, error) {
+	listParams := noteListParams{PageParams: params}
+	switch {
+	case user == nil:
+		return listParams, missing("User")
+	case user.ID != "":
+		listParams.IntercomUserID = user.ID
+	case user.UserID != "":
+		listParams.UserID = user.UserID
+	case user.Email != "":
+		listParams.Email = user.Email
+	default:
+		return listParams, missing("User Identifier")
+	}
+	return listParams, nil
+}
+
+func (n Note) String() string {
+	return fmt.Sprintf("[intercom] note { id: %s, body: %s }", n.ID, n.Body)
+}
@@ -0,0 +1,36 @@
+package intercom
+
+import (
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// NoteRepository defines the interface for working with Notes through the API.
+type NoteRepository interface {
+	list(params noteListParams) (NoteList, error)
+	save(note *requestNote) (Note, error)
+}
+
+// NoteAPI implements NoteRepository
+type NoteAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+func (api NoteAPI) list(params noteListParams) (NoteList, error) {
+	noteList := NoteList{}
+	data, err := api.httpClient.Get("/notes", params)
+	if err != nil {
+		return noteList, err
+	}
+	err = unmarshal("/notes", data, &noteList)
+	return noteList, err
+}
+
+func (api NoteAPI) save(note *requestNote) (Note, error) {
+	savedNote := Note{}
+	data, err := api.httpClient.Post("/notes", note)
+	if err != nil {
+		return savedNote, err
+	}
+	err = unmarshal("/notes", data, &savedNote)
+	return savedNote, err
+}
@@ -0,0 +1,58 @@
+package intercom
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestNoteAPIList(t *testing.T) {
+	http := TestNoteHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/notes.json", expectedURI: "/notes"}
+	api := NoteAPI{httpClient: &http}
+	noteList, err := api.list(noteListParams{UserID: "123"})
+	if err != nil {
+		t.Fatalf("Error listing notes: %v", err)
+	}
+	if noteList.TotalCount != 52 {
+		t.Errorf("TotalCount was %d, expected 52", noteList.TotalCount)
+	}
+	if noteList.Pages.TotalPages != 2 {
+		t.Errorf("TotalPages was %d, expected 2", noteList.Pages.TotalPages)
+	}
+	note := noteList.Notes[0]
+	if note.ID != "2068966" || note.Author.ID != "1" || note.User.ID != "54c42e7ea7a765fa7" {
+		t.Errorf("Note was not parsed, got %v", note)
+	}
+}
+
+func TestNoteAPISave(t *testing.T) {
+	http := TestNoteHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/note.json", expectedURI: "/notes"}
+	api := NoteAPI{httpClient: &http}
+	note, err := api.save(&requestNote{Body: "<p>Text for the note</p>", User: requestNoteUser{UserID: "123"}})
+	if err != nil {
+		t.Fatalf("Error saving note: %v", err)
+	}
+	if note.ID != "2068966" {
+		t.Errorf("Note ID was %s, expected 2068966", note.ID)
+	}
+}
+
+type TestNoteHTTPClient struct {
+	TestHTTPClient
+	t               *testing.T
+	fixtureFilename string
+	expectedURI     string
+}
+
+func (t TestNoteHTTPClient) Get(uri string, queryParams interface{}) ([]byte, error) {
+	if t.expectedURI != uri {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	return ioutil.ReadFile(t.fixtureFilename)
+}
+
+func (t TestNoteHTTPClient) Post(uri string, body interface{}) ([]byte, error) {
+	if t.expectedURI != uri {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	return ioutil.ReadFile(t.fixtureFilename)
+}
@@ -0,0 +1,115 @@
+package intercom
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestNoteList(t *testing.T) {
+	noteList, _ := (&NoteService{Repository: &TestNoteAPI{t: t, pages: 1}}).List(&User{UserID: "27"}, PageParams{})
+	if noteList.Notes[0].ID != "1-0" {
+		t.Errorf("Notes not listed")
+	}
+}
+
+func TestNoteListAll(t *testing.T) {
+	repo := &TestNoteAPI{t: t, pages: 3}
+	var ids []string
+	err := (&NoteService{Repository: repo}).ListAll(&User{UserID: "27"}, func(note Note) error {
+		ids = append(ids, note.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error listing all notes: %v", err)
+	}
+	if len(ids) != 6 || ids[0] != "1-0" || ids[5] != "3-1" {
+		t.Errorf("Expected notes from every page, got %v", ids)
+	}
+}
+
+func TestNoteListAllStopsEarly(t *testing.T) {
+	repo := &TestNoteAPI{t: t, pages: 3}
+	stop := errors.New("stop")
+	count := 0
+	err := (&NoteService{Repository: repo}).ListAll(&User{UserID: "27"}, func(note Note) error {
+		count++
+		return stop
+	})
+	if err != stop || count != 1 || repo.requests != 1 {
+		t.Errorf("Expected to stop after the first note, got %v after %d notes and %d requests", err, count, repo.requests)
+	}
+}
+
+func TestNoteListMissingUser(t *testing.T) {
+	repo := &TestNoteAPI{t: t, pages: 1}
+	noteService := NoteService{Repository: repo}
+	for _, user := range []*User{nil, &User{}} {
+		if _, err := noteService.List(user, PageParams{}); !errors.As(err, &ArgumentError{}) {
+			t.Errorf("Expected an ArgumentError listing Notes for %v, got %v", user, err)
+		}
+		if err := noteService.ListAll(user, func(Note) error { return nil }); !errors.As(err, &ArgumentError{}) {
+			t.Errorf("Expected an ArgumentError listing all Notes for %v, got %v", user, err)
+		}
+	}
+	if repo.requests != 0 {
+		t.Errorf("Expected no requests, got %d", repo.requests)
+	}
+}
+
+func TestNoteNew(t *testing.T) {
+	repo := &TestNoteAPI{t: t}
+	noteService := NoteService{Repository: repo}
+	noteService.New(&User{ID: "54c42e7e", UserID: "27", Email: "jamie@example.io"}, &Admin{ID: "1"}, "hi")
+	if repo.saved.User != (requestNoteUser{ID: "54c42e7e"}) || repo.saved.AdminID != "1" || repo.saved.Body != "hi" {
+		t.Errorf("Expected note on user 54c42e7e by admin 1, got %+v", repo.saved)
+	}
+	noteService.New(&User{UserID: "27", Email: "jamie@example.io"}, nil, "hi")
+	if repo.saved.User != (requestNoteUser{UserID: "27"}) || repo.saved.AdminID != "" {
+		t.Errorf("Expected note on user_id 27 without admin, got %+v", repo.saved)
+	}
+	noteService.New(&User{Email: "jamie@example.io"}, nil, "hi")
+	if repo.saved.User != (requestNoteUser{Email: "jamie@example.io"}) {
+		t.Errorf("Expected note on email jamie@example.io, got %+v", repo.saved)
+	}
+}
+
+func TestNoteNewMissingIdentifier(t *testing.T) {
+	repo := &TestNoteAPI{t: t}
+	if _, err := (&NoteService{Repository: repo}).New(&User{}, nil, "hi"); err == nil {
+		t.Errorf("Expected a User without identifiers to be rejected")
+	}
+	if _, err := (&NoteService{Repository: repo}).New(nil, nil, "hi"); !errors.As(err, &ArgumentError{}) {
+		t.Errorf("Expected an ArgumentError for a nil User, got %v", err)
+	}
+	if repo.saved != nil {
+		t.Errorf("Expected no note to be saved")
+	}
+}
+
+type TestNoteAPI struct {
+	t        *testing.T
+	pages    int64
+	requests int
+	saved    *requestNote
+}
+
+func (t *TestNoteAPI) save(note *requestNote) (Note, error) {
+	t.saved = note
+	return Note{Body: note.Body}, nil
+}
+
+func (t *TestNoteAPI) list(params noteListParams) (NoteList, error) {
+	t.requests++
+	if params.UserID != "27" {
+		t.t.Errorf("UserID was %s, expected 27", params.UserID)
+	}
+	page := params.Page
+	if page == 0 {
+		page = 1
+	}
+	return NoteList{
+		Pages: PageParams{Page: page, TotalPages: t.pages},
+		Notes: []Note{Note{ID: fmt.Sprintf("%d-0", page)}, Note{ID: fmt.Sprintf("%d-1", page)}},
+	}, nil
+}
@@ -3,9 +3,13 @@ package intercom
 import (
 	"encoding/json"
 	"io"
+	"strings"
 )
 
 // Notification is the object delivered to a webhook.
+// At most one of Conversation, User, Tag, Company, Contact, Event or Ping is populated,
+// depending on the Topic. Notifications for other topics leave them all nil,
+// with the item still available from Item.
 type Notification struct {
 	ID               string        `json:"id,omitempty"`
 	CreatedAt        int64         `json:"created_at,omitempty"`
@@ -17,7 +21,15 @@ type Notification struct {
 	User             *User         `json:"-"`
 	Tag              *Tag          `json:"-"`
 	Company          *Company      `json:"-"`
+	Contact          *Contact      `json:"-"`
 	Event            *Event        `json:"-"`
+	Ping             *PingItem     `json:"-"`
+}
+
+// PingItem is the item of a test Notification, sent by SubscriptionService.Ping.
+type PingItem struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
 }
 
 // Data is the data node of the notification.
@@ -28,7 +40,40 @@ type Data struct {
 // NewNotification parses a Notification from json read from an io.Reader.
 // It may only contain partial objects (such as a single conversation part)
 // depending on what is provided by the webhook.
+// Items which cannot be decoded are left unset, use ParseNotification to have the error returned.
 func NewNotification(r io.Reader) (*Notification, error) {
+	notification, err := decodeNotification(r)
+	if err != nil {
+		return nil, err
+	}
+	notification.decodeItem()
+	return notification, nil
+}
+
+// ParseNotification parses a Notification from json read from an io.Reader,
+// decoding its item into the field matching its Topic.
+// Notifications with unknown topics are parsed without error, with the item
+// available from Item.
+func ParseNotification(r io.Reader) (*Notification, error) {
+	notification, err := decodeNotification(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := notification.decodeItem(); err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+// Item returns the raw json of the Notification's item.
+func (n *Notification) Item() json.RawMessage {
+	if n == nil || n.RawData == nil {
+		return nil
+	}
+	return n.RawData.Item
+}
+
+func decodeNotification(r io.Reader) (*Notification, error) {
 	notification := &Notification{
 		RawData: &Data{},
 	}
@@ -36,38 +81,57 @@ func NewNotification(r io.Reader) (*Notification, error) {
 	if err != nil {
 		return nil, err
 	}
-	switch notification.Topic {
-	case "conversation.user.created",
-		"conversation.user.replied",
-		"conversation.admin.replied",
-		"conversation.admin.single.created",
-		"conversation.admin.assigned",
-		"conversation.admin.noted",
-		"conversation.admin.closed",
-		"conversation.admin.opened":
+	return notification, nil
+}
+
+// decodeItem decodes the item into the field matching the Topic, leaving it unset if it cannot be decoded.
+func (n *Notification) decodeItem() error {
+	if len(n.Item()) == 0 {
+		return nil
+	}
+	switch {
+	case n.Topic == TopicPing:
+		p := &PingItem{}
+		if err := json.Unmarshal(n.Item(), p); err != nil {
+			return err
+		}
+		n.Ping = p
+	case strings.HasPrefix(n.Topic, "conversation."):
 		c := &Conversation{}
-		json.Unmarshal(notification.RawData.Item, c)
-		notification.Conversation = c
-	case "user.created",
-		"user.deleted",
-		"user.unsubscribed",
-		"user.email.updated":
-		u := &User{}
-		json.Unmarshal(notification.RawData.Item, u)
-		notification.User = u
-	case "user.tag.created",
-		"user.tag.deleted":
+		if err := json.Unmarshal(n.Item(), c); err != nil {
+			return err
+		}
+		n.Conversation = c
+	case strings.HasPrefix(n.Topic, "user.tag."), strings.HasPrefix(n.Topic, "contact.tag."):
 		t := &Tag{}
-		json.Unmarshal(notification.RawData.Item, t)
-		notification.Tag = t
-	case "company.created":
+		if err := json.Unmarshal(n.Item(), t); err != nil {
+			return err
+		}
+		n.Tag = t
+	case strings.HasPrefix(n.Topic, "user."):
+		u := &User{}
+		if err := json.Unmarshal(n.Item(), u); err != nil {
+			return err
+		}
+		n.User = u
+	case strings.HasPrefix(n.Topic, "contact."):
+		c := &Contact{}
+		if err := json.Unmarshal(n.Item(), c); err != nil {
+			return err
+		}
+		n.Contact = c
+	case strings.HasPrefix(n.Topic, "company."):
 		c := &Company{}
-		json.Unmarshal(notification.RawData.Item, c)
-		notification.Company = c
-	case "event.created":
+		if err := json.Unmarshal(n.Item(), c); err != nil {
+			return err
+		}
+		n.Company = c
+	case strings.HasPrefix(n.Topic, "event."):
 		e := &Event{}
-		json.Unmarshal(notification.RawData.Item, e)
-		notification.Event = e
+		if err := json.Unmarshal(n.Item(), e); err != nil {
+			return err
+		}
+		n.Event = e
 	}
-	return notification, nil
+	return nil
 }
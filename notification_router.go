@@ -0,0 +1,191 @@
+package intercom
+
+// Notification topics, as found in Notification.Topic and Subscription.Topics.
+const (
+	TopicConversationUserCreated        = "conversation.user.created"
+	TopicConversationUserReplied        = "conversation.user.replied"
+	TopicConversationAdminReplied       = "conversation.admin.replied"
+	TopicConversationAdminSingleCreated = "conversation.admin.single.created"
+	TopicConversationAdminAssigned      = "conversation.admin.assigned"
+	TopicConversationAdminNoted         = "conversation.admin.noted"
+	TopicConversationAdminClosed        = "conversation.admin.closed"
+	TopicConversationAdminOpened        = "conversation.admin.opened"
+	TopicConversationAdminSnoozed       = "conversation.admin.snoozed"
+	TopicConversationAdminUnsnoozed     = "conversation.admin.unsnoozed"
+	TopicConversationRatingAdded        = "conversation.rating.added"
+	TopicUserCreated                    = "user.created"
+	TopicUserDeleted                    = "user.deleted"
+	TopicUserUnsubscribed               = "user.unsubscribed"
+	TopicUserEmailUpdated               = "user.email.updated"
+	TopicUserTagCreated                 = "user.tag.created"
+	TopicUserTagDeleted                 = "user.tag.deleted"
+	TopicContactCreated                 = "contact.created"
+	TopicContactSignedUp                = "contact.signed_up"
+	TopicContactAddedEmail              = "contact.added_email"
+	TopicContactTagCreated              = "contact.tag.created"
+	TopicContactTagDeleted              = "contact.tag.deleted"
+	TopicCompanyCreated                 = "company.created"
+	TopicEventCreated                   = "event.created"
+	TopicPing                           = "ping"
+)
+
+// NotificationHandlerFunc handles a Notification dispatched by a NotificationRouter.
+type NotificationHandlerFunc func(*Notification) error
+
+// NotificationRouter dispatches Notifications to handlers by their Topic.
+type NotificationRouter struct {
+	handlers       map[string]NotificationHandlerFunc
+	defaultHandler NotificationHandlerFunc
+}
+
+// NewNotificationRouter returns an empty NotificationRouter.
+// Notifications for unhandled topics are ignored until a default handler is set.
+func NewNotificationRouter() *NotificationRouter {
+	return &NotificationRouter{handlers: map[string]NotificationHandlerFunc{}}
+}
+
+// Handle registers fn for Notifications with the given topic, replacing any existing handler.
+func (r *NotificationRouter) Handle(topic string, fn NotificationHandlerFunc) {
+	r.handlers[topic] = fn
+}
+
+// HandleDefault registers fn for Notifications with no handler for their topic.
+func (r *NotificationRouter) HandleDefault(fn NotificationHandlerFunc) {
+	r.defaultHandler = fn
+}
+
+// Dispatch passes a Notification to the handler for its topic, returning its error.
+func (r *NotificationRouter) Dispatch(n *Notification) error {
+	if fn, ok := r.handlers[n.Topic]; ok {
+		return fn(n)
+	}
+	if r.defaultHandler != nil {
+		return r.defaultHandler(n)
+	}
+	return nil
+}
+
+// HandleConversationUserCreated registers fn for conversation.user.created notifications.
+func (r *NotificationRouter) HandleConversationUserCreated(fn NotificationHandlerFunc) {
+	r.Handle(TopicConversationUserCreated, fn)
+}
+
+// HandleConversationUserReplied registers fn for conversation.user.replied notifications.
+func (r *NotificationRouter) HandleConversationUserReplied(fn NotificationHandlerFunc) {
+	r.Handle(TopicConversationUserReplied, fn)
+}
+
+// HandleConversationAdminReplied registers fn for conversation.admin.replied notifications.
+func (r *NotificationRouter) HandleConversationAdminReplied(fn NotificationHandlerFunc) {
+	r.Handle(TopicConversationAdminReplied, fn)
+}
+
+// HandleConversationAdminSingleCreated registers fn for conversation.admin.single.created notifications.
+func (r *NotificationRouter) HandleConversationAdminSingleCreated(fn NotificationHandlerFunc) {
+	r.Handle(TopicConversationAdminSingleCreated, fn)
+}
+
+// HandleConversationAdminAssigned registers fn for conversation.admin.assigned notifications.
+func (r *NotificationRouter) HandleConversationAdminAssigned(fn NotificationHandlerFunc) {
+	r.Handle(TopicConversationAdminAssigned, fn)
+}
+
+// HandleConversationAdminNoted registers fn for conversation.admin.noted notifications.
+func (r *NotificationRouter) HandleConversationAdminNoted(fn NotificationHandlerFunc) {
+	r.Handle(TopicConversationAdminNoted, fn)
+}
+
+// HandleConversationAdminClosed registers fn for conversation.admin.closed notifications.
+func (r *NotificationRouter) HandleConversationAdminClosed(fn NotificationHandlerFunc) {
+	r.Handle(TopicConversationAdminClosed, fn)
+}
+
+// HandleConversationAdminOpened registers fn for conversation.admin.opened notifications.
+func (r *NotificationRouter) HandleConversationAdminOpened(fn NotificationHandlerFunc) {
+	r.Handle(TopicConversationAdminOpened, fn)
+}
+
+// HandleConversationAdminSnoozed registers fn for conversation.admin.snoozed notifications.
+func (r *NotificationRouter) HandleConversationAdminSnoozed(fn NotificationHandlerFunc) {
+	r.Handle(TopicConversationAdminSnoozed, fn)
+}
+
+// HandleConversationAdminUnsnoozed registers fn for conversation.admin.unsnoozed notifications.
+func (r *NotificationRouter) HandleConversationAdminUnsnoozed(fn NotificationHandlerFunc) {
+	r.Handle(TopicConversationAdminUnsnoozed, fn)
+}
+
+// HandleConversationRatingAdded registers fn for conversation.rating.added notifications.
+func (r *NotificationRouter) HandleConversationRatingAdded(fn NotificationHandlerFunc) {
+	r.Handle(TopicConversationRatingAdded, fn)
+}
+
+// HandleUserCreated registers fn for user.created notifications.
+func (r *NotificationRouter) HandleUserCreated(fn NotificationHandlerFunc) {
+	r.Handle(TopicUserCreated, fn)
+}
+
+// HandleUserDeleted registers fn for user.deleted notifications.
+func (r *NotificationRouter) HandleUserDeleted(fn NotificationHandlerFunc) {
+	r.Handle(TopicUserDeleted, fn)
+}
+
+// HandleUserUnsubscribed registers fn for user.unsubscribed notifications.
+func (r *NotificationRouter) HandleUserUnsubscribed(fn NotificationHandlerFunc) {
+	r.Handle(TopicUserUnsubscribed, fn)
+}
+
+// HandleUserEmailUpdated registers fn for user.email.updated notifications.
+func (r *NotificationRouter) HandleUserEmailUpdated(fn NotificationHandlerFunc) {
+	r.Handle(TopicUserEmailUpdated, fn)
+}
+
+// HandleUserTagCreated registers fn for user.tag.created notifications.
+func (r *NotificationRouter) HandleUserTagCreated(fn NotificationHandlerFunc) {
+	r.Handle(TopicUserTagCreated, fn)
+}
+
+// HandleUserTagDeleted registers fn for user.tag.deleted notifications.
+func (r *NotificationRouter) HandleUserTagDeleted(fn NotificationHandlerFunc) {
+	r.Handle(TopicUserTagDeleted, fn)
+}
+
+// HandleContactCreated registers fn for contact.created notifications.
+func (r *NotificationRouter) HandleContactCreated(fn NotificationHandlerFunc) {
+	r.Handle(TopicContactCreated, fn)
+}
+
+// HandleContactSignedUp registers fn for contact.signed_up notifications.
+func (r *NotificationRouter) HandleContactSignedUp(fn NotificationHandlerFunc) {
+	r.Handle(TopicContactSignedUp, fn)
+}
+
+// HandleContactAddedEmail registers fn for contact.added_email notifications.
+func (r *NotificationRouter) HandleContactAddedEmail(fn NotificationHandlerFunc) {
+	r.Handle(TopicContactAddedEmail, fn)
+}
+
+// HandleContactTagCreated registers fn for contact.tag.created notifications.
+func (r *NotificationRouter) HandleContactTagCreated(fn NotificationHandlerFunc) {
+	r.Handle(TopicContactTagCreated, fn)
+}
+
+// HandleContactTagDeleted registers fn for contact.tag.deleted notifications.
+func (r *NotificationRouter) HandleContactTagDeleted(fn NotificationHandlerFunc) {
+	r.Handle(TopicContactTagDeleted, fn)
+}
+
+// HandleCompanyCreated registers fn for company.created notifications.
+func (r *NotificationRouter) HandleCompanyCreated(fn NotificationHandlerFunc) {
+	r.Handle(TopicCompanyCreated, fn)
+}
+
+// HandleEventCreated registers fn for event.created notifications.
+func (r *NotificationRouter) HandleEventCreated(fn NotificationHandlerFunc) {
+	r.Handle(TopicEventCreated, fn)
+}
+
+// HandlePing registers fn for ping notifications.
+func (r *NotificationRouter) HandlePing(fn NotificationHandlerFunc) {
+	r.Handle(TopicPing, fn)
+}
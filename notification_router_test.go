@@ -0,0 +1,43 @@
+package intercom
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNotificationRouterDispatch(t *testing.T) {
+	router := NewNotificationRouter()
+	var replied, created bool
+	router.HandleConversationAdminReplied(func(n *Notification) error {
+		replied = true
+		return nil
+	})
+	router.HandleUserCreated(func(n *Notification) error {
+		created = true
+		return errTest
+	})
+
+	if err := router.Dispatch(&Notification{Topic: TopicConversationAdminReplied}); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if !replied || created {
+		t.Errorf("Notification was not routed by topic")
+	}
+	if err := router.Dispatch(&Notification{Topic: "user.created"}); err != errTest {
+		t.Errorf("Handler error was not returned, got %v", err)
+	}
+}
+
+func TestNotificationRouterDefault(t *testing.T) {
+	router := NewNotificationRouter()
+	if err := router.Dispatch(&Notification{Topic: "visitor.signed_up"}); err != nil {
+		t.Errorf("Unhandled topics should be ignored without a default, got %v", err)
+	}
+	errUnhandled := errors.New("Unhandled")
+	router.HandleDefault(func(n *Notification) error {
+		return errUnhandled
+	})
+	if err := router.Dispatch(&Notification{Topic: "visitor.signed_up"}); err != errUnhandled {
+		t.Errorf("Default handler was not called, got %v", err)
+	}
+}
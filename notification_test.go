@@ -18,7 +18,7 @@ func (r *alwaysErrorReader) Read(p []byte) (n int, err error) {
 }
 
 func TestParsingFromReader(t *testing.T) {
-	r, _ := os.Open("fixtures/notification.json")
+	r, _ := os.Open("intercomtest/fixtures/notification.json")
 	n, _ := NewNotification(r)
 	if n.ID != "notif_ccd8a4d0-f965-11e3-a367-c779cae3e1b3" {
 		t.Errorf("Notification did not have ID")
@@ -73,7 +73,7 @@ func TestParsingConverationFromReader(t *testing.T) {
 	}
 
 	for _, topic := range topics {
-		payload, _ := ioutil.ReadFile("fixtures/conversation.json")
+		payload, _ := ioutil.ReadFile("intercomtest/fixtures/conversation.json")
 		r := strings.NewReader(fmt.Sprintf(`{
 			"topic": "%s",
 			"data": {
@@ -96,7 +96,7 @@ func TestParsingUserFromReader(t *testing.T) {
 	}
 
 	for _, topic := range topics {
-		payload, _ := ioutil.ReadFile("fixtures/user.json")
+		payload, _ := ioutil.ReadFile("intercomtest/fixtures/user.json")
 		r := strings.NewReader(fmt.Sprintf(`{
 			"topic": "%s",
 			"data": {
@@ -117,7 +117,7 @@ func TestParsingTagFromReader(t *testing.T) {
 	}
 
 	for _, topic := range topics {
-		payload, _ := ioutil.ReadFile("fixtures/tag.json")
+		payload, _ := ioutil.ReadFile("intercomtest/fixtures/tag.json")
 		r := strings.NewReader(fmt.Sprintf(`{
 			"topic": "%s",
 			"data": {
@@ -137,7 +137,7 @@ func TestParsingEventFromReader(t *testing.T) {
 	}
 
 	for _, topic := range topics {
-		payload, _ := ioutil.ReadFile("fixtures/event.json")
+		payload, _ := ioutil.ReadFile("intercomtest/fixtures/event.json")
 		r := strings.NewReader(fmt.Sprintf(`{
 			"topic": "%s",
 			"data": {
@@ -150,3 +150,57 @@ func TestParsingEventFromReader(t *testing.T) {
 		}
 	}
 }
+
+func TestParseNotificationContact(t *testing.T) {
+	payload, _ := ioutil.ReadFile("intercomtest/fixtures/contact.json")
+	r := strings.NewReader(fmt.Sprintf(`{"topic": "contact.created", "data": {"item": %s}}`, string(payload)))
+	n, err := ParseNotification(r)
+	if err != nil {
+		t.Fatalf("Error parsing notification: %v", err)
+	}
+	if n.Contact == nil || n.Contact.ID != "54c42e7ea7a765fa7" {
+		t.Errorf("Notification did not have Contact")
+	}
+	if n.User != nil {
+		t.Errorf("Notification should not have User")
+	}
+}
+
+func TestParseNotificationUnknownTopic(t *testing.T) {
+	r := strings.NewReader(`{"topic": "visitor.signed_up", "data": {"item": {"type": "visitor", "id": "abc"}}}`)
+	n, err := ParseNotification(r)
+	if err != nil {
+		t.Fatalf("Error parsing notification: %v", err)
+	}
+	if n.Topic != "visitor.signed_up" {
+		t.Errorf("Topic was %s, expected visitor.signed_up", n.Topic)
+	}
+	if string(n.Item()) != `{"type": "visitor", "id": "abc"}` {
+		t.Errorf("Item was %s", n.Item())
+	}
+	if n.Conversation != nil || n.User != nil || n.Tag != nil || n.Company != nil || n.Contact != nil || n.Event != nil {
+		t.Errorf("Notification should not have a typed item")
+	}
+}
+
+func TestParseNotificationItemError(t *testing.T) {
+	r := strings.NewReader(`{"topic": "user.created", "data": {"item": {"id": 123}}}`)
+	if _, err := ParseNotification(r); err == nil {
+		t.Errorf("Error not returned for undecodable item")
+	}
+	r = strings.NewReader(`{"topic": "user.created", "data": {"item": {"id": 123}}}`)
+	if _, err := NewNotification(r); err != nil {
+		t.Errorf("NewNotification should ignore item errors, got %v", err)
+	}
+}
+
+func TestParseNotificationPing(t *testing.T) {
+	r := strings.NewReader(`{"type": "notification_event", "topic": "ping", "data": {"item": {"type": "ping", "message": "something something interzen"}}}`)
+	n, err := ParseNotification(r)
+	if err != nil {
+		t.Fatalf("Error parsing notification: %v", err)
+	}
+	if n.Ping == nil || n.Ping.Message != "something something interzen" {
+		t.Errorf("Notification did not have Ping, got %v", n.Ping)
+	}
+}
@@ -0,0 +1,89 @@
+package intercom
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+const oauthTokenPath = "/auth/eagle/token"
+
+// Token is an OAuth access token for an App which has installed yours, with the Admin who installed it.
+type Token struct {
+	AccessToken string
+	TokenType   string
+
+	// AppID is the id_code of the App, or workspace, the token belongs to.
+	AppID string
+	Admin Admin
+}
+
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	Token       string `json:"token"`
+	TokenType   string `json:"token_type"`
+}
+
+// ExchangeOAuthCode exchanges the code from an OAuth install for an access token,
+// and reads the App it belongs to. The token can be used with NewOAuthClient.
+func ExchangeOAuthCode(ctx context.Context, clientID, clientSecret, code string) (Token, error) {
+	return exchangeOAuthCode(ctx, http.DefaultClient, defaultBaseURI, clientID, clientSecret, code)
+}
+
+func exchangeOAuthCode(ctx context.Context, httpClient *http.Client, baseURI, clientID, clientSecret, code string) (Token, error) {
+	token := Token{}
+	form := url.Values{"client_id": {clientID}, "client_secret": {clientSecret}, "code": {code}}
+	req, err := http.NewRequestWithContext(ctx, "POST", baseURI+oauthTokenPath, strings.NewReader(form.Encode()))
+	if err != nil {
+		return token, err
+	}
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return token, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return token, err
+	}
+	if resp.StatusCode >= 400 {
+		return token, oauthError(data, resp.StatusCode)
+	}
+
+	response := oauthTokenResponse{}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return token, err
+	}
+	token.AccessToken, token.TokenType = response.AccessToken, response.TokenType
+	if token.AccessToken == "" {
+		token.AccessToken = response.Token
+	}
+
+	ic := NewOAuthClient(token.AccessToken)
+	ic.Option(BaseURI(baseURI), SetNetHTTPClient(httpClient))
+	token.Admin, err = ic.WithContext(ctx).Admins.Me()
+	if err != nil {
+		return token, err
+	}
+	if token.Admin.App != nil {
+		token.AppID = token.Admin.App.IDCode
+	}
+	return token, nil
+}
+
+func oauthError(data []byte, statusCode int) error {
+	errorList := interfaces.HTTPErrorList{}
+	if err := json.Unmarshal(data, &errorList); err != nil || len(errorList.Errors) == 0 {
+		return interfaces.NewUnknownHTTPError(statusCode)
+	}
+	httpError := errorList.Errors[0]
+	httpError.StatusCode = statusCode
+	return httpError
+}
@@ -0,0 +1,58 @@
+package intercom
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestOAuthServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/eagle/token":
+			if r.PostFormValue("code") != "good_code" || r.PostFormValue("client_id") != "client_id" || r.PostFormValue("client_secret") != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"type":"error.list","errors":[{"code":"unauthorized","message":"Invalid code"}]}`))
+				return
+			}
+			w.Write([]byte(`{"token_type":"Bearer","token":"access_token","access_token":"access_token"}`))
+		case "/me":
+			if r.Header.Get("Authorization") != "Bearer access_token" {
+				t.Errorf("Authorization was %s", r.Header.Get("Authorization"))
+			}
+			data, _ := ioutil.ReadFile("intercomtest/fixtures/me.json")
+			w.Write(data)
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestExchangeOAuthCode(t *testing.T) {
+	server := newTestOAuthServer(t)
+	defer server.Close()
+
+	token, err := exchangeOAuthCode(context.Background(), server.Client(), server.URL, "client_id", "secret", "good_code")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if token.AccessToken != "access_token" || token.TokenType != "Bearer" {
+		t.Errorf("Token was %+v", token)
+	}
+	if token.AppID != "this_is_an_id64_that_should_be_at_least_4" || token.Admin.Email != "admin_a@example.io" {
+		t.Errorf("App was %s, Admin was %s", token.AppID, token.Admin)
+	}
+}
+
+func TestExchangeOAuthCodeRejected(t *testing.T) {
+	server := newTestOAuthServer(t)
+	defer server.Close()
+
+	_, err := exchangeOAuthCode(context.Background(), server.Client(), server.URL, "client_id", "secret", "bad_code")
+	herr, ok := err.(IntercomError)
+	if !ok || herr.GetStatusCode() != http.StatusUnauthorized || herr.GetMessage() != "Invalid code" {
+		t.Errorf("Expected an unauthorized error, got %v", err)
+	}
+}
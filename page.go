@@ -1,8 +1,111 @@
 package intercom
 
-// PageParams determine paging information to and from the API
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// PageParams determine paging information to and from the API.
+// Resources paged with a cursor, such as Contacts and Conversations, take a StartingAfter cursor,
+// from Next, instead of a Page number; when both are set the cursor takes precedence and Page isn't sent.
 type PageParams struct {
-	Page       int64 `json:"page" url:"page,omitempty"`
-	PerPage    int64 `json:"per_page" url:"per_page,omitempty"`
-	TotalPages int64 `json:"total_pages" url:"-"`
+	Page          int64       `json:"page" url:"page,omitempty"`
+	PerPage       int64       `json:"per_page" url:"per_page,omitempty"`
+	TotalPages    int64       `json:"total_pages" url:"-"`
+	StartingAfter string      `json:"-" url:"starting_after,omitempty"`
+	Next          *CursorNext `json:"next,omitempty" url:"-"`
+}
+
+// Page sizes. Lists given no PerPage ask for DefaultPerPage, and a PerPage over the list's maximum
+// is lowered to it, rather than sending a request Intercom would clamp, or reject, itself.
+const (
+	DefaultPerPage = 50
+	// MaxPerPage is the most Users, Companies, Events, Notes, Collections, Sections,
+	// News items and Subscription deliveries a page can have.
+	MaxPerPage = 50
+	// MaxConversationsPerPage is the most Conversations a page can have.
+	MaxConversationsPerPage = 60
+	// MaxCursorPerPage is the most a page of a list paged with a cursor, such as Contacts, Articles
+	// and Ticket searches, can have.
+	MaxCursorPerPage = 150
+)
+
+// limit checks the PageParams aren't negative, returning an ArgumentError if they are,
+// and sets PerPage to DefaultPerPage if it isn't set, or to max if it's more.
+func (p PageParams) limit(max int64) (PageParams, error) {
+	if p.Page < 0 || p.PerPage < 0 {
+		return p, ArgumentError{Message: "Page and PerPage Must Not Be Negative"}
+	}
+	p.PerPage = perPage(p.PerPage, max)
+	return p, nil
+}
+
+// limit checks PerPage isn't negative, returning an ArgumentError if it is,
+// and sets it to DefaultPerPage if it isn't set, or to max if it's more.
+func (p CursorParams) limit(max int64) (CursorParams, error) {
+	if p.PerPage < 0 {
+		return p, ArgumentError{Message: "PerPage Must Not Be Negative"}
+	}
+	p.PerPage = perPage(p.PerPage, max)
+	return p, nil
+}
+
+func perPage(perPage, max int64) int64 {
+	switch {
+	case perPage == 0:
+		perPage = DefaultPerPage
+	case perPage > max:
+		perPage = max
+	}
+	return perPage
+}
+
+// cursor drops the Page number when paging by a StartingAfter cursor.
+func (p PageParams) cursor() PageParams {
+	if p.StartingAfter != "" {
+		p.Page = 0
+	}
+	return p
+}
+
+// CursorParams determine paging information to the API for resources paged with a cursor.
+type CursorParams struct {
+	PerPage       int64  `json:"per_page,omitempty" url:"per_page,omitempty"`
+	StartingAfter string `json:"starting_after,omitempty" url:"starting_after,omitempty"`
+}
+
+// CursorPages holds paging information from the API for resources paged with a cursor.
+// Next is nil on the last page.
+type CursorPages struct {
+	Page       int64       `json:"page"`
+	PerPage    int64       `json:"per_page"`
+	TotalPages int64       `json:"total_pages"`
+	Next       *CursorNext `json:"next,omitempty"`
+}
+
+// CursorNext identifies the next page of a resource paged with a cursor.
+// Events are paged by Before, the time of the oldest Event on the page, instead.
+type CursorNext struct {
+	Page          int64  `json:"page"`
+	StartingAfter string `json:"starting_after"`
+	Before        int64  `json:"before,omitempty"`
+}
+
+// UnmarshalJSON decodes the next page from an object, or from the URL of the next page older API versions send.
+func (n *CursorNext) UnmarshalJSON(data []byte) error {
+	var next string
+	if err := json.Unmarshal(data, &next); err != nil {
+		type cursorNext CursorNext
+		return json.Unmarshal(data, (*cursorNext)(n))
+	}
+	u, err := url.Parse(next)
+	if err != nil {
+		return err
+	}
+	query := u.Query()
+	n.Page, _ = strconv.ParseInt(query.Get("page"), 10, 64)
+	n.StartingAfter = query.Get("starting_after")
+	n.Before, _ = strconv.ParseInt(query.Get("before"), 10, 64)
+	return nil
 }
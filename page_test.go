@@ -0,0 +1,136 @@
+package intercom
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestPageParamsNextCursor(t *testing.T) {
+	pages := PageParams{}
+	if err := json.Unmarshal([]byte(`{"page": 1, "per_page": 50, "next": {"page": 2, "starting_after": "WzE2ODQ="}}`), &pages); err != nil {
+		t.Fatal(err)
+	}
+	if pages.Next == nil || pages.Next.Page != 2 || pages.Next.StartingAfter != "WzE2ODQ=" {
+		t.Errorf("Next was %+v, expected page 2 starting after WzE2ODQ=", pages.Next)
+	}
+}
+
+func TestPageParamsNextURL(t *testing.T) {
+	pages := PageParams{}
+	if err := json.Unmarshal([]byte(`{"page": 1, "next": "https://api.intercom.io/contacts?per_page=50&page=2"}`), &pages); err != nil {
+		t.Fatal(err)
+	}
+	if pages.Next == nil || pages.Next.Page != 2 || pages.Next.StartingAfter != "" {
+		t.Errorf("Next was %+v, expected page 2", pages.Next)
+	}
+}
+
+func TestPageParamsLastPage(t *testing.T) {
+	pages := PageParams{}
+	if err := json.Unmarshal([]byte(`{"page": 3, "next": null}`), &pages); err != nil {
+		t.Fatal(err)
+	}
+	if pages.Next != nil {
+		t.Errorf("Next was %+v, expected nil", pages.Next)
+	}
+}
+
+func TestPageParamsCursorPrecedence(t *testing.T) {
+	values, _ := query.Values(contactListParams{PageParams: PageParams{Page: 2, PerPage: 10, StartingAfter: "WzE2ODQ="}.cursor()})
+	if values.Get("page") != "" {
+		t.Errorf("page was %s, expected it not to be sent", values.Get("page"))
+	}
+	if values.Get("starting_after") != "WzE2ODQ=" || values.Get("per_page") != "10" {
+		t.Errorf("query was %s, expected per_page=10&starting_after=WzE2ODQ=", values.Encode())
+	}
+
+	values, _ = query.Values(contactListParams{PageParams: PageParams{Page: 2, Next: &CursorNext{StartingAfter: "WzE2ODQ="}}.cursor()})
+	if values.Get("page") != "2" || values.Get("starting_after") != "" {
+		t.Errorf("query was %s, expected page=2", values.Encode())
+	}
+}
+
+func TestPageParamsLimit(t *testing.T) {
+	for _, test := range []struct {
+		perPage, max, expected int64
+	}{
+		{0, MaxPerPage, DefaultPerPage},
+		{20, MaxPerPage, 20},
+		{100, MaxPerPage, MaxPerPage},
+		{100, MaxConversationsPerPage, MaxConversationsPerPage},
+		{100, MaxCursorPerPage, 100},
+		{500, MaxCursorPerPage, MaxCursorPerPage},
+	} {
+		params, err := PageParams{Page: 2, PerPage: test.perPage}.limit(test.max)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if params.PerPage != test.expected || params.Page != 2 {
+			t.Errorf("PerPage %d limited to %d was %d, expected %d", test.perPage, test.max, params.PerPage, test.expected)
+		}
+	}
+}
+
+func TestPageParamsLimitNegative(t *testing.T) {
+	for _, params := range []PageParams{{Page: -1}, {PerPage: -1}} {
+		if _, err := params.limit(MaxPerPage); !errors.As(err, &ArgumentError{}) {
+			t.Errorf("error for %+v was %v, expected an ArgumentError", params, err)
+		}
+	}
+	if _, err := (CursorParams{PerPage: -1}).limit(MaxCursorPerPage); !errors.As(err, &ArgumentError{}) {
+		t.Errorf("error was %v, expected an ArgumentError", err)
+	}
+}
+
+func TestCursorParamsLimit(t *testing.T) {
+	params, _ := CursorParams{StartingAfter: "WzE2ODQ="}.limit(MaxCursorPerPage)
+	if params.PerPage != DefaultPerPage || params.StartingAfter != "WzE2ODQ=" {
+		t.Errorf("params were %+v, expected PerPage %d", params, DefaultPerPage)
+	}
+	params, _ = CursorParams{PerPage: 1000}.limit(MaxCursorPerPage)
+	if params.PerPage != MaxCursorPerPage {
+		t.Errorf("PerPage was %d, expected %d", params.PerPage, MaxCursorPerPage)
+	}
+}
+
+type pageParamsConversationAPI struct {
+	TestConversationAPI
+	listed []ConversationListParams
+}
+
+func (api *pageParamsConversationAPI) List(params ConversationListParams) (ConversationList, error) {
+	api.listed = append(api.listed, params)
+	return ConversationList{}, nil
+}
+
+func TestConversationListPerPageLimits(t *testing.T) {
+	api := &pageParamsConversationAPI{}
+	service := ConversationService{Repository: api}
+	service.ListAll(PageParams{PerPage: 100})
+	service.ListAll(PageParams{})
+	if len(api.listed) != 2 || api.listed[0].PerPage != MaxConversationsPerPage || api.listed[1].PerPage != DefaultPerPage {
+		t.Errorf("listed %+v, expected PerPage %d then %d", api.listed, MaxConversationsPerPage, DefaultPerPage)
+	}
+
+	_, err := service.ListAll(PageParams{PerPage: -5})
+	if !errors.As(err, &ArgumentError{}) || len(api.listed) != 2 {
+		t.Errorf("error was %v after %d requests, expected an ArgumentError and no request", err, len(api.listed))
+	}
+	conversations := service.All(PageParams{Page: -1})
+	if conversations.Next() || !errors.As(conversations.Err(), &ArgumentError{}) {
+		t.Errorf("Pager error was %v, expected an ArgumentError", conversations.Err())
+	}
+}
+
+func TestPageParamsNextBefore(t *testing.T) {
+	pages := PageParams{}
+	if err := json.Unmarshal([]byte(`{"next": "https://api.intercom.io/events?type=user&intercom_user_id=54c42e7ea7a765fa7&before=1389913800"}`), &pages); err != nil {
+		t.Fatal(err)
+	}
+	if pages.Next == nil || pages.Next.Before != 1389913800 || pages.Next.Page != 0 {
+		t.Errorf("Next was %+v, expected before 1389913800", pages.Next)
+	}
+}
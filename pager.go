@@ -0,0 +1,86 @@
+package intercom
+
+// A Pager iterates over the items of a paged list, fetching each page from the API as it's needed:
+//
+//	users := ic.Users.All(intercom.PageParams{PerPage: 50})
+//	for users.Next() {
+//		user := users.Item()
+//	}
+//	if err := users.Err(); err != nil {
+//		...
+//	}
+//
+// No more pages are fetched once the caller stops calling Next, so it's fine to break out early.
+type Pager[T any] struct {
+	fetch  func(PageParams) ([]T, PageParams, error)
+	params PageParams
+	items  []T
+	item   T
+	err    error
+	done   bool
+}
+
+// NewPager returns a Pager which starts at the page params, fetching each page with fetch,
+// which returns the page's items and its paging information from the API.
+// Pages are followed by their Next cursor, else by Page number until TotalPages.
+func NewPager[T any](params PageParams, fetch func(PageParams) ([]T, PageParams, error)) *Pager[T] {
+	return &Pager[T]{fetch: fetch, params: params}
+}
+
+// Next moves to the next item, fetching the next page if needed.
+// It returns false after the last item, or if a page couldn't be fetched, when Err returns the error.
+func (p *Pager[T]) Next() bool {
+	for len(p.items) == 0 {
+		if p.done || p.err != nil {
+			return false
+		}
+		p.fetchPage()
+	}
+	p.item, p.items = p.items[0], p.items[1:]
+	return true
+}
+
+// Item is the current item, which Next moved to.
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Err is the error fetching a page, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+func (p *Pager[T]) fetchPage() {
+	items, pages, err := p.fetch(p.params)
+	if err != nil {
+		p.err = err
+		return
+	}
+	p.items = items
+	p.params, p.done = nextPage(p.params, pages)
+	if len(items) == 0 {
+		p.done = true
+	}
+}
+
+// nextPage works out the params for the page after pages, or returns done if it was the last page.
+func nextPage(params PageParams, pages PageParams) (next PageParams, done bool) {
+	page := pages.Page
+	if page == 0 {
+		page = params.Page
+		if page == 0 {
+			page = 1
+		}
+	}
+	switch {
+	case pages.Next != nil && pages.Next.StartingAfter != "":
+		params.Page, params.StartingAfter = 0, pages.Next.StartingAfter
+	case pages.TotalPages > 0 && page < pages.TotalPages:
+		params.Page = page + 1
+	case pages.TotalPages == 0 && pages.Next != nil && pages.Next.Page > page:
+		params.Page = pages.Next.Page
+	default:
+		return params, true
+	}
+	return params, false
+}
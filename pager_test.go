@@ -0,0 +1,116 @@
+package intercom
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type testPages struct {
+	pages   [][]int
+	cursor  bool
+	fetched []PageParams
+	err     error
+	errPage int
+}
+
+func (t *testPages) fetch(params PageParams) ([]int, PageParams, error) {
+	t.fetched = append(t.fetched, params)
+	page := int(params.Page)
+	if t.cursor {
+		page = 1
+		if params.StartingAfter != "" {
+			page = int(params.StartingAfter[0]-'0') + 1
+		}
+	} else if page == 0 {
+		page = 1
+	}
+	if t.err != nil && page == t.errPage {
+		return nil, PageParams{}, t.err
+	}
+	pages := PageParams{Page: int64(page), TotalPages: int64(len(t.pages))}
+	if t.cursor && page < len(t.pages) {
+		pages.TotalPages = 0
+		pages.Next = &CursorNext{StartingAfter: string(rune('0' + page))}
+	}
+	return t.pages[page-1], pages, nil
+}
+
+func collect(pager *Pager[int]) []int {
+	items := []int{}
+	for pager.Next() {
+		items = append(items, pager.Item())
+	}
+	return items
+}
+
+func TestPagerPageNumbers(t *testing.T) {
+	pages := &testPages{pages: [][]int{{1, 2}, {3}, {4, 5}}}
+	items := collect(NewPager(PageParams{PerPage: 2}, pages.fetch))
+	if !reflect.DeepEqual(items, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("items were %v, expected 1 to 5", items)
+	}
+	if len(pages.fetched) != 3 || pages.fetched[2].Page != 3 || pages.fetched[2].PerPage != 2 {
+		t.Errorf("fetched %+v, expected pages 1 to 3", pages.fetched)
+	}
+}
+
+func TestPagerCursor(t *testing.T) {
+	pages := &testPages{pages: [][]int{{1, 2}, {3}, {4}}, cursor: true}
+	items := collect(NewPager(PageParams{Page: 1}, pages.fetch))
+	if !reflect.DeepEqual(items, []int{1, 2, 3, 4}) {
+		t.Errorf("items were %v, expected 1 to 4", items)
+	}
+	if last := pages.fetched[len(pages.fetched)-1]; last.StartingAfter != "2" || last.Page != 0 {
+		t.Errorf("last fetch was %+v, expected only a cursor", last)
+	}
+}
+
+func TestPagerNextURL(t *testing.T) {
+	fetched := 0
+	pager := NewPager(PageParams{}, func(params PageParams) ([]int, PageParams, error) {
+		fetched++
+		if params.Page == 2 {
+			return []int{2}, PageParams{Page: 2}, nil
+		}
+		return []int{1}, PageParams{Page: 1, Next: &CursorNext{Page: 2}}, nil
+	})
+	if items := collect(pager); !reflect.DeepEqual(items, []int{1, 2}) || fetched != 2 {
+		t.Errorf("items were %v from %d fetches, expected 1 and 2 from 2", items, fetched)
+	}
+}
+
+func TestPagerError(t *testing.T) {
+	pages := &testPages{pages: [][]int{{1}, {2}, {3}}, err: errors.New("boom"), errPage: 2}
+	pager := NewPager(PageParams{}, pages.fetch)
+	if items := collect(pager); !reflect.DeepEqual(items, []int{1}) {
+		t.Errorf("items were %v, expected 1", items)
+	}
+	if pager.Err() != pages.err {
+		t.Errorf("Err was %v, expected boom", pager.Err())
+	}
+	if pager.Next() || len(pages.fetched) != 2 {
+		t.Errorf("expected no more fetches after an error, fetched %d", len(pages.fetched))
+	}
+}
+
+func TestPagerStopsEarly(t *testing.T) {
+	pages := &testPages{pages: [][]int{{1, 2}, {3}}}
+	pager := NewPager(PageParams{}, pages.fetch)
+	for pager.Next() {
+		if pager.Item() == 2 {
+			break
+		}
+	}
+	if len(pages.fetched) != 1 {
+		t.Errorf("fetched %d pages, expected 1", len(pages.fetched))
+	}
+}
+
+func TestPagerEmptyPage(t *testing.T) {
+	pages := &testPages{pages: [][]int{{}, {1}}}
+	pager := NewPager(PageParams{}, pages.fetch)
+	if pager.Next() || pager.Err() != nil || len(pages.fetched) != 1 {
+		t.Errorf("expected an empty page to end the Pager, fetched %d", len(pages.fetched))
+	}
+}
@@ -0,0 +1,42 @@
+package intercom
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// ErrInvalidPhoneNumber is returned when a phone number is invalid, or not supported by Intercom.
+var ErrInvalidPhoneNumber = errors.New("Invalid Phone Number")
+
+// The code of the error Intercom returns for a phone number it can't send to.
+const invalidPhoneNumberCode = "invalid_phone_number"
+
+var e164PhoneNumber = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// PhoneCallRedirectService handles interactions with the API through a PhoneCallRedirectRepository.
+type PhoneCallRedirectService struct {
+	Repository PhoneCallRedirectRepository
+}
+
+// PhoneCallRedirect is a redirect of a phone call to the Messenger, by SMS.
+type PhoneCallRedirect struct {
+	Type  string `json:"type"`
+	Phone string `json:"phone"`
+}
+
+// Create a PhoneCallRedirect, sending a link to the Messenger to a phone number
+// in E.164 format, such as "+353832345678".
+// An error matching ErrInvalidPhoneNumber with errors.Is is returned if the number is invalid or unsupported;
+// when it's Intercom which says so, the error also unwraps to Intercom's error, with its message, code and request ID.
+func (p *PhoneCallRedirectService) Create(phone string) (PhoneCallRedirect, error) {
+	if !e164PhoneNumber.MatchString(phone) {
+		return PhoneCallRedirect{}, ErrInvalidPhoneNumber
+	}
+	redirect, err := p.Repository.create(phone)
+	var intercomErr IntercomError
+	if errors.As(err, &intercomErr) && intercomErr.GetCode() == invalidPhoneNumberCode {
+		return PhoneCallRedirect{}, fmt.Errorf("%w: %w", ErrInvalidPhoneNumber, err)
+	}
+	return redirect, err
+}
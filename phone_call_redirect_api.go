@@ -0,0 +1,29 @@
+package intercom
+
+import (
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// PhoneCallRedirectRepository defines the interface for creating PhoneCallRedirects through the API.
+type PhoneCallRedirectRepository interface {
+	create(phone string) (PhoneCallRedirect, error)
+}
+
+// PhoneCallRedirectAPI implements PhoneCallRedirectRepository
+type PhoneCallRedirectAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+type requestPhoneCallRedirect struct {
+	Phone string `json:"phone"`
+}
+
+func (api PhoneCallRedirectAPI) create(phone string) (PhoneCallRedirect, error) {
+	redirect := PhoneCallRedirect{}
+	data, err := api.httpClient.Post("/phone_call_redirects", &requestPhoneCallRedirect{Phone: phone})
+	if err != nil {
+		return redirect, err
+	}
+	err = unmarshal("/phone_call_redirects", data, &redirect)
+	return redirect, err
+}
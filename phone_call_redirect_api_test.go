@@ -0,0 +1,22 @@
+package intercom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAPICreatePhoneCallRedirect(t *testing.T) {
+	http := TestTicketHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/phone_call_redirect.json", expectedURI: "/phone_call_redirects"}
+	api := PhoneCallRedirectAPI{httpClient: &http}
+	redirect, err := api.create("+353832345678")
+	if err != nil {
+		t.Fatalf("Error creating phone call redirect: %v", err)
+	}
+	if redirect.Phone != "+353832345678" {
+		t.Errorf("Phone was %s", redirect.Phone)
+	}
+	b, _ := json.Marshal(http.lastRequest)
+	if string(b) != `{"phone":"+353832345678"}` {
+		t.Errorf("Request was %s", b)
+	}
+}
@@ -0,0 +1,51 @@
+package intercom
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+func TestCreatePhoneCallRedirectInvalid(t *testing.T) {
+	phoneCallRedirectService := PhoneCallRedirectService{Repository: TestPhoneCallRedirectAPI{}}
+	for _, phone := range []string{"", "0832345678", "+0832345678", "+353 83 234 5678"} {
+		if _, err := phoneCallRedirectService.Create(phone); err != ErrInvalidPhoneNumber {
+			t.Errorf("Expected ErrInvalidPhoneNumber for %q, got %v", phone, err)
+		}
+	}
+}
+
+func TestCreatePhoneCallRedirectUnsupported(t *testing.T) {
+	phoneCallRedirectService := PhoneCallRedirectService{Repository: TestPhoneCallRedirectAPI{
+		err: interfaces.HTTPError{StatusCode: 422, Code: "invalid_phone_number", Message: "Phone number is not supported", RequestID: "req_123"},
+	}}
+	_, err := phoneCallRedirectService.Create("+881234567890")
+	if !errors.Is(err, ErrInvalidPhoneNumber) {
+		t.Errorf("Expected ErrInvalidPhoneNumber, got %v", err)
+	}
+	if err == nil || !strings.Contains(err.Error(), "invalid_phone_number") || !strings.Contains(err.Error(), "req_123") {
+		t.Errorf("Expected the error to keep Intercom's code and request ID, got %v", err)
+	}
+	var httpError interfaces.HTTPError
+	if !errors.As(err, &httpError) || httpError.RequestID != "req_123" {
+		t.Errorf("Expected the error to unwrap to the API error, got %v", err)
+	}
+}
+
+func TestCreatePhoneCallRedirectOtherError(t *testing.T) {
+	apiErr := interfaces.HTTPError{StatusCode: 422, Code: "parameter_invalid", Message: "Phone is a required parameter"}
+	phoneCallRedirectService := PhoneCallRedirectService{Repository: TestPhoneCallRedirectAPI{err: apiErr}}
+	if _, err := phoneCallRedirectService.Create("+353832345678"); err != apiErr {
+		t.Errorf("Expected the API error, got %v", err)
+	}
+}
+
+type TestPhoneCallRedirectAPI struct {
+	err error
+}
+
+func (t TestPhoneCallRedirectAPI) create(phone string) (PhoneCallRedirect, error) {
+	return PhoneCallRedirect{Phone: phone}, t.err
+}
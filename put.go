@@ -0,0 +1,16 @@
+package intercom
+
+import (
+	"errors"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// put makes a PUT request if the HTTPClient supports it, see interfaces.HTTPPutClient.
+func put(httpClient interfaces.HTTPClient, uri string, body interface{}) ([]byte, error) {
+	putClient, ok := currentHTTPClient(httpClient).(interfaces.HTTPPutClient)
+	if !ok {
+		return nil, errors.New("HTTP Client Does Not Support PUT")
+	}
+	return putClient.Put(uri, body)
+}
@@ -0,0 +1,64 @@
+package intercom
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// Do makes a request to an endpoint this package doesn't have a method for yet, with the Client's
+// authentication, base URI, retries, hooks and errors, decoding the JSON response into out, if not nil:
+//
+//	var articles struct {
+//		Data []map[string]interface{} `json:"data"`
+//	}
+//	err := ic.Do(ctx, "GET", "/articles/search", url.Values{"phrase": {"refunds"}}, nil, &articles)
+//
+// body is sent as JSON, for POST, PATCH, PUT and DELETE; a nil body is sent as no body for DELETE.
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, body interface{}, out interface{}) error {
+	if path == "" {
+		return missing("Path")
+	}
+	if len(query) > 0 {
+		separator := "?"
+		if strings.Contains(path, "?") {
+			separator = "&"
+		}
+		// as the HTTPClient encodes query parameters, with spaces as %20
+		path += separator + strings.ReplaceAll(query.Encode(), "+", "%20")
+	}
+	httpClient := c.WithContext(ctx).HTTPClient
+
+	var data []byte
+	var err error
+	switch strings.ToUpper(method) {
+	case http.MethodGet:
+		data, err = httpClient.Get(path, nil)
+	case http.MethodPost:
+		data, err = httpClient.Post(path, body)
+	case http.MethodPatch:
+		data, err = httpClient.Patch(path, body)
+	case http.MethodPut:
+		data, err = put(httpClient, path, body)
+	case http.MethodDelete:
+		if body == nil {
+			data, err = httpClient.Delete(path, nil)
+			break
+		}
+		deleteClient, ok := httpClient.(interfaces.HTTPDeleteBodyClient)
+		if !ok {
+			return errors.New("HTTP Client Does Not Support DELETE With A Body")
+		}
+		data, err = deleteClient.DeleteWithBody(path, body)
+	default:
+		return ArgumentError{Message: "Unsupported Method " + method}
+	}
+	if err != nil || out == nil {
+		return err
+	}
+	return unmarshalAccepted(path, data, out)
+}
@@ -0,0 +1,56 @@
+package intercom
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestClientDo(t *testing.T) {
+	var method, rawQuery, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		method, rawQuery, body = r.Method, r.URL.RawQuery, string(data)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"type":"error.list","errors":[{"code":"not_found","message":"Not Found"}]}`))
+			return
+		}
+		w.Write([]byte(`{"type":"thing","id":"1"}`))
+	}))
+	defer server.Close()
+	ic := NewClient("app_id", "api_key", BaseURI(server.URL))
+
+	var out struct {
+		ID string `json:"id"`
+	}
+	if err := ic.Do(context.Background(), "GET", "/things", url.Values{"phrase": {"a refund"}}, nil, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if method != "GET" || rawQuery != "phrase=a%20refund" || out.ID != "1" {
+		t.Errorf("Request was %s %s, decoded %+v", method, rawQuery, out)
+	}
+
+	if err := ic.Do(context.Background(), "put", "/things/1", nil, map[string]string{"name": "x"}, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if method != "PUT" || body != "{\"name\":\"x\"}\n" {
+		t.Errorf("Request was %s with %s", method, body)
+	}
+
+	if err := ic.Do(context.Background(), "DELETE", "/things/1", nil, nil, nil); err != nil || method != "DELETE" || body != "" {
+		t.Errorf("Request was %s with %q, %v", method, body, err)
+	}
+
+	if err := ic.Do(context.Background(), "GET", "/missing", nil, nil, &out); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	var argumentErr ArgumentError
+	if err := ic.Do(context.Background(), "TRACE", "/things", nil, nil, nil); !errors.As(err, &argumentErr) {
+		t.Errorf("Expected an ArgumentError, got %v", err)
+	}
+}
@@ -13,6 +13,24 @@ type Reply struct {
 	AttachmentURLs []string `json:"attachment_urls,omitempty"`
 }
 
+func newReply(author MessagePerson, replyType ReplyType, body string, attachmentURLs []string) Reply {
+	addr := author.MessageAddress()
+	reply := Reply{
+		Type:           addr.Type,
+		ReplyType:      replyType.String(),
+		Body:           body,
+		AttachmentURLs: attachmentURLs,
+	}
+	if addr.Type == "admin" {
+		reply.AdminID = addr.ID
+	} else {
+		reply.IntercomID = addr.ID
+		reply.UserID = addr.UserID
+		reply.Email = addr.Email
+	}
+	return reply
+}
+
 // ReplyType determines the type of Reply
 type ReplyType int
 
@@ -0,0 +1,161 @@
+package intercom
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DecodeError is returned when a response can't be decoded, such as when a field's type has changed.
+// Path is where in the response the value at fault is, such as conversations[3].pages.next,
+// and Snippet is the JSON around it, so the error can be logged and acted on.
+type DecodeError struct {
+	URL     string
+	Path    string
+	Snippet string
+	Err     error
+}
+
+func (e DecodeError) Error() string {
+	return fmt.Sprintf("Decoding %s: %v, at %s near %s", e.URL, e.Err, e.Path, e.Snippet)
+}
+
+func (e DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// snippetLength is how much JSON either side of a value at fault a DecodeError's Snippet has.
+const snippetLength = 40
+
+// unmarshal decodes the JSON response from uri into v, returning a DecodeError if it can't.
+func unmarshal(uri string, data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	if err == nil {
+		return nil
+	}
+	var offset int64
+	var typeErr *json.UnmarshalTypeError
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	default:
+		return err
+	}
+	return DecodeError{URL: uri, Path: jsonPath(data, offset), Snippet: snippet(data, offset), Err: err}
+}
+
+// unmarshalAccepted decodes a response's JSON body into v. Some endpoints accept a request
+// without returning anything, with a 202 or an empty 200 or 204; these succeed, leaving v as it was.
+func unmarshalAccepted(uri string, data []byte, v interface{}) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	return unmarshal(uri, data, v)
+}
+
+// jsonFrame is an object or array being read by jsonPath.
+type jsonFrame struct {
+	array bool
+	key   string
+	index int
+	// inValue is whether a value in the frame, at key or index, has started
+	inValue bool
+}
+
+// jsonPath returns the path to the value being read at offset in data, such as users[2].companies.
+func jsonPath(data []byte, offset int64) string {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	frames := []*jsonFrame{}
+	done := func() {
+		if len(frames) == 0 {
+			return
+		}
+		frame := frames[len(frames)-1]
+		if frame.array {
+			frame.index++
+		}
+		frame.inValue = false
+	}
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			break
+		}
+		var frame *jsonFrame
+		if len(frames) > 0 {
+			frame = frames[len(frames)-1]
+		}
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			if frame != nil && frame.array {
+				frame.inValue = true
+			}
+			frames = append(frames, &jsonFrame{array: token == json.Delim('[')})
+		case json.Delim('}'), json.Delim(']'):
+			frames = frames[:len(frames)-1]
+			if dec.InputOffset() >= offset {
+				return framesPath(frames)
+			}
+			done()
+			continue
+		default:
+			if frame != nil && !frame.array && !frame.inValue {
+				frame.key, frame.inValue = token.(string), true
+				continue
+			}
+			if frame != nil && frame.array {
+				frame.inValue = true
+			}
+		}
+		if dec.InputOffset() >= offset {
+			return framesPath(frames)
+		}
+		if _, ok := token.(json.Delim); !ok {
+			done()
+		}
+	}
+	return framesPath(frames)
+}
+
+func framesPath(frames []*jsonFrame) string {
+	var path strings.Builder
+	for _, frame := range frames {
+		if !frame.inValue {
+			break
+		}
+		if frame.array {
+			path.WriteString("[" + strconv.Itoa(frame.index) + "]")
+		} else {
+			if path.Len() > 0 {
+				path.WriteString(".")
+			}
+			path.WriteString(frame.key)
+		}
+	}
+	if path.Len() == 0 {
+		return "the top level"
+	}
+	return path.String()
+}
+
+// snippet returns the JSON either side of offset in data.
+func snippet(data []byte, offset int64) string {
+	start, end := offset-snippetLength, offset+snippetLength
+	if start < 0 {
+		start = 0
+	}
+	if end > int64(len(data)) {
+		end = int64(len(data))
+	}
+	if start > end {
+		start = end
+	}
+	return strings.Join(strings.Fields(string(data[start:end])), " ")
+}
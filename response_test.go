@@ -0,0 +1,66 @@
+package intercom
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalAccepted(t *testing.T) {
+	for _, data := range [][]byte{nil, []byte(""), []byte(" \n")} {
+		user := User{ID: "1"}
+		if err := unmarshalAccepted("/users/1", data, &user); err != nil || user.ID != "1" {
+			t.Errorf("Got %+v, %v from %q, expected the User to be left alone", user, err, data)
+		}
+	}
+	user := User{}
+	if err := unmarshalAccepted("/users/2", []byte(`{"id":"2"}`), &user); err != nil || user.ID != "2" {
+		t.Errorf("Got %+v, %v", user, err)
+	}
+	if err := unmarshalAccepted("/users/2", []byte(`{"id":`), &user); err == nil {
+		t.Errorf("Expected an error decoding invalid JSON")
+	}
+}
+
+func TestDeleteUserAccepted(t *testing.T) {
+	api := UserAPI{httpClient: TestHTTPClient{}}
+	if _, err := api.Delete("1234"); err != nil {
+		t.Errorf("Unexpected error deleting with an empty response: %v", err)
+	}
+}
+
+func TestUnmarshalDecodeError(t *testing.T) {
+	for _, test := range []struct {
+		data, path, snippet string
+	}{
+		{`{"conversations": [{"id": "1"}, {"id": "2", "open": "yes"}]}`, `conversations[1].open`, `"open": "yes"`},
+		{`{"conversations": [{"id": "1", "tags": {"tags": [{"id": 1}, {"name": 2}]}}]}`, `conversations[0].tags.tags[1].name`, `{"name": 2}`},
+		{`{"conversations": {"id": "1"}}`, `conversations`, `{"id": "1"}`},
+		{`{"pages": {"page": "one"}}`, `pages.page`, `"page": "one"`},
+		{`{"conversations": [{"id": "1"},]}`, `conversations`, `{"id": "1"},]`},
+		{`"conversations"`, `the top level`, `"conversations"`},
+	} {
+		list := ConversationList{}
+		err := unmarshal("/conversations", []byte(test.data), &list)
+		var decodeErr DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected a DecodeError from %s, got %v", test.data, err)
+			continue
+		}
+		if decodeErr.URL != "/conversations" || decodeErr.Path != test.path || !strings.Contains(decodeErr.Snippet, test.snippet) {
+			t.Errorf("Got %q at %s near %s from %s, expected %s near %s", decodeErr.URL, decodeErr.Path, decodeErr.Snippet, test.data, test.path, test.snippet)
+		}
+	}
+
+	long := `{"conversations": [{"id": "1", "title": "` + strings.Repeat("a", 200) + `", "open": 1}]}`
+	err := unmarshal("/conversations", []byte(long), &ConversationList{})
+	var decodeErr DecodeError
+	if !errors.As(err, &decodeErr) || len(decodeErr.Snippet) > 2*snippetLength {
+		t.Errorf("Expected a short snippet, got %v", err)
+	}
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Errorf("Expected to unwrap to the json error, got %v", err)
+	}
+}
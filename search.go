@@ -0,0 +1,91 @@
+package intercom
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Search operators, for comparing a field to a value in a SearchQuery.
+const (
+	SearchEquals      = "="
+	SearchNotEquals   = "!="
+	SearchIn          = "IN"
+	SearchNotIn       = "NIN"
+	SearchGreaterThan = ">"
+	SearchLessThan    = "<"
+	SearchContains    = "~"
+	SearchNotContains = "!~"
+	SearchStartsWith  = "^"
+	SearchEndsWith    = "$"
+)
+
+// SearchQuery is a query for the search endpoints, either comparing a Field
+// to a Value, or combining other SearchQueries with AND or OR.
+// Build them with Where, And and Or.
+type SearchQuery struct {
+	Field    string
+	Operator string
+	Value    interface{}
+	Queries  []SearchQuery
+}
+
+type requestSearch struct {
+	Query      SearchQuery   `json:"query"`
+	Pagination *CursorParams `json:"pagination,omitempty"`
+}
+
+// Where builds a SearchQuery comparing a field to a value, with one of the Search operators.
+func Where(field, operator string, value interface{}) SearchQuery {
+	return SearchQuery{Field: field, Operator: operator, Value: value}
+}
+
+// And builds a SearchQuery matching all of the given queries.
+func And(queries ...SearchQuery) SearchQuery {
+	return SearchQuery{Operator: "AND", Queries: queries}
+}
+
+// Or builds a SearchQuery matching any of the given queries.
+func Or(queries ...SearchQuery) SearchQuery {
+	return SearchQuery{Operator: "OR", Queries: queries}
+}
+
+// MarshalJSON encodes the SearchQuery as Intercom's query DSL.
+func (q SearchQuery) MarshalJSON() ([]byte, error) {
+	if q.Queries != nil {
+		return json.Marshal(struct {
+			Operator string        `json:"operator"`
+			Value    []SearchQuery `json:"value"`
+		}{q.Operator, q.Queries})
+	}
+	return json.Marshal(struct {
+		Field    string      `json:"field"`
+		Operator string      `json:"operator"`
+		Value    interface{} `json:"value"`
+	}{q.Field, q.Operator, q.Value})
+}
+
+func (q SearchQuery) validate() error {
+	if q.Queries != nil {
+		if len(q.Queries) == 0 {
+			return errors.New("Empty Search Query Group")
+		}
+		for _, query := range q.Queries {
+			if err := query.validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if q.Field == "" || q.Operator == "" {
+		return missing("Search Query Field or Operator")
+	}
+	return nil
+}
+
+func newRequestSearch(query SearchQuery, params CursorParams) *requestSearch {
+	search := &requestSearch{Query: query}
+	if params != (CursorParams{}) {
+		search.Pagination = &params
+	}
+	return search
+}
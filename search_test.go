@@ -0,0 +1,30 @@
+package intercom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSearchQueryJSON(t *testing.T) {
+	query := And(
+		Where("open", SearchEquals, true),
+		Or(Where("ticket_type_id", SearchEquals, "1295"), Where("ticket_type_id", SearchIn, []string{"1296", "1297"})),
+	)
+	b, _ := json.Marshal(newRequestSearch(query, CursorParams{PerPage: 5}))
+	expected := `{"query":{"operator":"AND","value":[{"field":"open","operator":"=","value":true},{"operator":"OR","value":[{"field":"ticket_type_id","operator":"=","value":"1295"},{"field":"ticket_type_id","operator":"IN","value":["1296","1297"]}]}]},"pagination":{"per_page":5}}`
+	if string(b) != expected {
+		t.Errorf("Search was %s, expected %s", b, expected)
+	}
+}
+
+func TestSearchQueryValidate(t *testing.T) {
+	if err := And().validate(); err == nil {
+		t.Errorf("Expected an error for an empty group")
+	}
+	if err := And(Where("", SearchEquals, true)).validate(); err == nil {
+		t.Errorf("Expected an error for a missing field")
+	}
+	if err := Where("open", SearchEquals, true).validate(); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package intercom
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrSectionNotEmpty is returned when deleting a Section which still contains Articles.
+// Move or delete its Articles first.
+var ErrSectionNotEmpty = errors.New("Section Not Empty")
+
+// The code of the error Intercom returns for deleting a Section which still contains Articles.
+const sectionNotEmptyCode = "section_not_empty"
+
+// SectionService handles interactions with the API through a SectionRepository.
+type SectionService struct {
+	Repository SectionRepository
+}
+
+// Section represents a Help Center Section, which groups Articles within a Collection.
+type Section struct {
+	Type              string                 `json:"type,omitempty"`
+	ID                string                 `json:"id,omitempty"`
+	WorkspaceID       string                 `json:"workspace_id,omitempty"`
+	Name              string                 `json:"name,omitempty"`
+	TranslatedContent GroupTranslatedContent `json:"translated_content,omitempty"`
+	ParentID          string                 `json:"parent_id,omitempty"`
+	Order             int64                  `json:"order,omitempty"`
+	DefaultLocale     string                 `json:"default_locale,omitempty"`
+	URL               string                 `json:"url,omitempty"`
+	CreatedAt         int64                  `json:"created_at,omitempty"`
+	UpdatedAt         int64                  `json:"updated_at,omitempty"`
+}
+
+// SectionList holds a page of Sections and paging information
+type SectionList struct {
+	Pages      PageParams `json:"pages"`
+	TotalCount int64      `json:"total_count"`
+	Sections   []Section  `json:"data"`
+}
+
+// Find a Section by its ID.
+func (s *SectionService) Find(id string) (Section, error) {
+	if id == "" {
+		return Section{}, missing("Section ID")
+	}
+	return s.Repository.find(id)
+}
+
+// List a page of Sections.
+func (s *SectionService) List(params PageParams) (SectionList, error) {
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return SectionList{}, err
+	}
+	return s.Repository.list(params)
+}
+
+// Create a Section, which needs a Name and the ParentID of its Collection.
+func (s *SectionService) Create(section *Section) (Section, error) {
+	if section.Name == "" || section.ParentID == "" {
+		return Section{}, missing("Section Name or Parent Collection")
+	}
+	return s.Repository.create(section)
+}
+
+// Update a Section. Only the fields set on section are sent.
+func (s *SectionService) Update(id string, section *Section) (Section, error) {
+	if id == "" {
+		return Section{}, missing("Section ID")
+	}
+	return s.Repository.update(id, section)
+}
+
+// Delete a Section by its ID.
+// An error matching ErrSectionNotEmpty with errors.Is is returned if the Section still contains Articles,
+// which also unwraps to Intercom's error.
+func (s *SectionService) Delete(id string) error {
+	if id == "" {
+		return missing("Section ID")
+	}
+	err := s.Repository.delete(id)
+	var intercomErr IntercomError
+	if errors.As(err, &intercomErr) && intercomErr.GetCode() == sectionNotEmptyCode {
+		return fmt.Errorf("%w: %w", ErrSectionNotEmpty, err)
+	}
+	return err
+}
+
+func (s Section) String() string {
+	return fmt.Sprintf("[intercom] section { id: %s, name: %s, parent_id: %s }", s.ID, s.Name, s.ParentID)
+}
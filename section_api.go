@@ -0,0 +1,82 @@
+package intercom
+
+import (
+	"fmt"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// SectionRepository defines the interface for working with Sections through the API.
+type SectionRepository interface {
+	find(id string) (Section, error)
+	list(params PageParams) (SectionList, error)
+	create(*Section) (Section, error)
+	update(id string, section *Section) (Section, error)
+	delete(id string) error
+}
+
+// SectionAPI implements SectionRepository
+type SectionAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+type requestSection struct {
+	Name              string                 `json:"name,omitempty"`
+	TranslatedContent GroupTranslatedContent `json:"translated_content,omitempty"`
+	ParentID          string                 `json:"parent_id,omitempty"`
+}
+
+func (api SectionAPI) find(id string) (Section, error) {
+	uri := fmt.Sprintf("/help_center/sections/%s", id)
+	data, err := api.httpClient.Get(uri, nil)
+	if err != nil {
+		return Section{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api SectionAPI) list(params PageParams) (SectionList, error) {
+	sectionList := SectionList{}
+	data, err := api.httpClient.Get("/help_center/sections", params)
+	if err != nil {
+		return sectionList, err
+	}
+	err = unmarshal("/help_center/sections", data, &sectionList)
+	return sectionList, err
+}
+
+func (api SectionAPI) create(section *Section) (Section, error) {
+	data, err := api.httpClient.Post("/help_center/sections", buildRequestSection(section))
+	if err != nil {
+		return Section{}, err
+	}
+	return api.unmarshal("/help_center/sections", data)
+}
+
+func (api SectionAPI) update(id string, section *Section) (Section, error) {
+	uri := fmt.Sprintf("/help_center/sections/%s", id)
+	data, err := put(api.httpClient, uri, buildRequestSection(section))
+	if err != nil {
+		return Section{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api SectionAPI) delete(id string) error {
+	_, err := api.httpClient.Delete(fmt.Sprintf("/help_center/sections/%s", id), nil)
+	return err
+}
+
+func (api SectionAPI) unmarshal(uri string, data []byte) (Section, error) {
+	section := Section{}
+	err := unmarshal(uri, data, &section)
+	return section, err
+}
+
+func buildRequestSection(section *Section) *requestSection {
+	return &requestSection{
+		Name:              section.Name,
+		TranslatedContent: section.TranslatedContent,
+		ParentID:          section.ParentID,
+	}
+}
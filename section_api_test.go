@@ -0,0 +1,52 @@
+package intercom
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+)
+
+func TestAPIFindSection(t *testing.T) {
+	http := TestSectionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/section.json", expectedURI: "/help_center/sections/171"}
+	api := SectionAPI{httpClient: &http}
+	section, err := api.find("171")
+	if err != nil {
+		t.Fatalf("Error finding section: %v", err)
+	}
+	if section.ID != "171" || section.ParentID != "165" || section.Order != 1 {
+		t.Errorf("Section was not parsed, got %v", section)
+	}
+}
+
+func TestAPICreateSection(t *testing.T) {
+	http := TestSectionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/section.json", expectedURI: "/help_center/sections"}
+	api := SectionAPI{httpClient: &http}
+	api.create(&Section{Name: "Refunds", ParentID: "165"})
+	b, _ := json.Marshal(http.lastRequest)
+	if string(b) != `{"name":"Refunds","parent_id":"165"}` {
+		t.Errorf("Request was %s", b)
+	}
+}
+
+type TestSectionHTTPClient struct {
+	TestHTTPClient
+	t               *testing.T
+	fixtureFilename string
+	expectedURI     string
+	lastRequest     interface{}
+}
+
+func (t *TestSectionHTTPClient) Get(uri string, params interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	return ioutil.ReadFile(t.fixtureFilename)
+}
+
+func (t *TestSectionHTTPClient) Post(uri string, body interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	t.lastRequest = body
+	return ioutil.ReadFile(t.fixtureFilename)
+}
@@ -0,0 +1,61 @@
+package intercom
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+func TestCreateSectionMissingParent(t *testing.T) {
+	sectionService := SectionService{Repository: TestSectionAPI{}}
+	if _, err := sectionService.Create(&Section{Name: "Refunds"}); err == nil {
+		t.Errorf("Expected an error for a missing parent collection")
+	}
+}
+
+func TestDeleteSectionNotEmpty(t *testing.T) {
+	sectionService := SectionService{Repository: TestSectionAPI{
+		err: interfaces.HTTPError{StatusCode: 400, Code: "section_not_empty", Message: "Section can't be deleted as it contains articles"},
+	}}
+	err := sectionService.Delete("171")
+	if !errors.Is(err, ErrSectionNotEmpty) {
+		t.Errorf("Expected ErrSectionNotEmpty, got %v", err)
+	}
+	var httpError interfaces.HTTPError
+	if !errors.As(err, &httpError) || httpError.StatusCode != 400 {
+		t.Errorf("Expected the error to unwrap to the API error, got %v", err)
+	}
+}
+
+func TestDeleteSectionError(t *testing.T) {
+	notFound := interfaces.HTTPError{StatusCode: 404, Code: "not_found", Message: "Resource Not Found"}
+	sectionService := SectionService{Repository: TestSectionAPI{err: notFound}}
+	if err := sectionService.Delete("171"); err != notFound {
+		t.Errorf("Expected the API error, got %v", err)
+	}
+}
+
+type TestSectionAPI struct {
+	err error
+}
+
+func (t TestSectionAPI) find(id string) (Section, error) {
+	return Section{ID: id}, nil
+}
+
+func (t TestSectionAPI) list(params PageParams) (SectionList, error) {
+	return SectionList{}, nil
+}
+
+func (t TestSectionAPI) create(section *Section) (Section, error) {
+	return *section, nil
+}
+
+func (t TestSectionAPI) update(id string, section *Section) (Section, error) {
+	return *section, nil
+}
+
+func (t TestSectionAPI) delete(id string) error {
+	return t.err
+}
@@ -1,7 +1,6 @@
 package intercom
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"gopkg.in/intercom/intercom-go.v2/interfaces"
@@ -9,8 +8,8 @@ import (
 
 // SegmentRepository defines the interface for working with Segments through the API.
 type SegmentRepository interface {
-	list() (SegmentList, error)
-	find(id string) (Segment, error)
+	list(params segmentListParams) (SegmentList, error)
+	find(id string, params segmentFindParams) (Segment, error)
 }
 
 // SegmentAPI implements SegmentRepository
@@ -18,22 +17,23 @@ type SegmentAPI struct {
 	httpClient interfaces.HTTPClient
 }
 
-func (api SegmentAPI) list() (SegmentList, error) {
+func (api SegmentAPI) list(params segmentListParams) (SegmentList, error) {
 	segmentList := SegmentList{}
-	data, err := api.httpClient.Get("/segments", nil)
+	data, err := api.httpClient.Get("/segments", params)
 	if err != nil {
 		return segmentList, err
 	}
-	err = json.Unmarshal(data, &segmentList)
+	err = unmarshal("/segments", data, &segmentList)
 	return segmentList, err
 }
 
-func (api SegmentAPI) find(id string) (Segment, error) {
+func (api SegmentAPI) find(id string, params segmentFindParams) (Segment, error) {
 	segment := Segment{}
-	data, err := api.httpClient.Get(fmt.Sprintf("/segments/%s", id), nil)
+	uri := fmt.Sprintf("/segments/%s", id)
+	data, err := api.httpClient.Get(uri, params)
 	if err != nil {
 		return segment, err
 	}
-	err = json.Unmarshal(data, &segment)
+	err = unmarshal(uri, data, &segment)
 	return segment, err
 }
@@ -3,15 +3,20 @@ package intercom
 import (
 	"io/ioutil"
 	"testing"
+
+	"github.com/google/go-querystring/query"
 )
 
 func TestAPIListSegments(t *testing.T) {
-	http := TestSegmentHTTPClient{t: t, fixtureFilename: "fixtures/segments.json", expectedURI: "/segments"}
+	http := TestSegmentHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/segments.json", expectedURI: "/segments"}
 	api := SegmentAPI{httpClient: &http}
-	segmentList, err := api.list()
+	segmentList, err := api.list(segmentListParams{})
 	if err != nil {
 		t.Fatalf(err.Error())
 	}
+	if segmentList.Segments[0].Type != "segment" {
+		t.Errorf("Segment list should carry type, but had %s", segmentList.Segments[0].Type)
+	}
 	if segmentList.Segments[0].ID != "5443ac9b316c12246c000005" {
 		t.Errorf("Segment list should start with segment 5443ac9b316c12246c000005, but had %s", segmentList.Segments[0].ID)
 	}
@@ -20,10 +25,19 @@ func TestAPIListSegments(t *testing.T) {
 	}
 }
 
+func TestAPIListCompanySegments(t *testing.T) {
+	http := TestSegmentHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/segments.json", expectedURI: "/segments"}
+	api := SegmentAPI{httpClient: &http}
+	api.list(segmentListParams{Type: "company"})
+	if v, _ := query.Values(http.lastQueryParams); v.Get("type") != "company" {
+		t.Errorf("type should be company, was %s", v.Encode())
+	}
+}
+
 func TestAPIFindSegment(t *testing.T) {
-	http := TestSegmentHTTPClient{t: t, fixtureFilename: "fixtures/segment.json", expectedURI: "/segments/5443ac9b316c12246c000005"}
+	http := TestSegmentHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/segment.json", expectedURI: "/segments/5443ac9b316c12246c000005"}
 	api := SegmentAPI{httpClient: &http}
-	segment, err := api.find("5443ac9b316c12246c000005")
+	segment, err := api.find("5443ac9b316c12246c000005", segmentFindParams{})
 	if err != nil {
 		t.Fatalf(err.Error())
 	}
@@ -35,16 +49,34 @@ func TestAPIFindSegment(t *testing.T) {
 	}
 }
 
+func TestAPIFindSegmentIncludeCount(t *testing.T) {
+	http := TestSegmentHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/segment.json", expectedURI: "/segments/5443ac9b316c12246c000005"}
+	api := SegmentAPI{httpClient: &http}
+	api.find("5443ac9b316c12246c000005", segmentFindParams{})
+	if v, _ := query.Values(http.lastQueryParams); v.Get("include_count") != "" {
+		t.Errorf("include_count should not be sent unless requested, was %s", v.Encode())
+	}
+	segment, _ := api.find("5443ac9b316c12246c000005", segmentFindParams{IncludeCount: true})
+	if v, _ := query.Values(http.lastQueryParams); v.Get("include_count") != "true" {
+		t.Errorf("include_count should be sent when requested, was %s", v.Encode())
+	}
+	if segment.Count != 13 {
+		t.Errorf("Segment should have count 13, but had %d", segment.Count)
+	}
+}
+
 type TestSegmentHTTPClient struct {
 	TestHTTPClient
 	t               *testing.T
 	fixtureFilename string
 	expectedURI     string
+	lastQueryParams interface{}
 }
 
-func (t TestSegmentHTTPClient) Get(uri string, params interface{}) ([]byte, error) {
+func (t *TestSegmentHTTPClient) Get(uri string, params interface{}) ([]byte, error) {
 	if uri != t.expectedURI {
 		t.t.Errorf("Wrong endpoint called")
 	}
+	t.lastQueryParams = params
 	return ioutil.ReadFile(t.fixtureFilename)
 }
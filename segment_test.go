@@ -10,6 +10,39 @@ func TestListSegments(t *testing.T) {
 	}
 }
 
+func TestListCompanySegments(t *testing.T) {
+	segmentList, _ := (&SegmentService{Repository: TestSegmentAPI{t: t}}).ListCompanySegments()
+	segments := segmentList.Segments
+	if segments[0].ID != "fe213dac5" {
+		t.Errorf("Got segment with ID %s, expected fe213dac5", segments[0].ID)
+	}
+}
+
+func TestListSegmentContacts(t *testing.T) {
+	segmentService := SegmentService{Repository: TestSegmentAPI{t: t}, UserRepository: TestSegmentUserAPI{}}
+	userList, err := segmentService.ListContacts("de412cad4", PageParams{Page: 2})
+	if err != nil {
+		t.Fatalf("Error listing segment contacts: %v", err)
+	}
+	if userList.Users[0].ID != "46adad3f09126dca" {
+		t.Errorf("Segment contacts not listed")
+	}
+	if userList.Pages.Page != 2 {
+		t.Errorf("Page was %d, expected 2", userList.Pages.Page)
+	}
+}
+
+func TestListEmptySegmentContacts(t *testing.T) {
+	segmentService := SegmentService{Repository: TestSegmentAPI{t: t}, UserRepository: TestSegmentUserAPI{}}
+	userList, err := segmentService.ListContacts("empty", PageParams{})
+	if err != nil {
+		t.Fatalf("Error listing empty segment contacts: %v", err)
+	}
+	if userList.Users == nil || len(userList.Users) != 0 {
+		t.Errorf("Expected an empty page, got %v", userList.Users)
+	}
+}
+
 func TestFindSegment(t *testing.T) {
 	segment, _ := (&SegmentService{Repository: TestSegmentAPI{t: t}}).Find("de412cad4")
 	if segment.ID != "de412cad4" {
@@ -17,14 +50,44 @@ func TestFindSegment(t *testing.T) {
 	}
 }
 
+func TestFindSegmentWithCount(t *testing.T) {
+	segmentService := SegmentService{Repository: TestSegmentAPI{t: t}}
+	segment, _ := segmentService.Find("de412cad4")
+	if segment.Count != 0 {
+		t.Errorf("Expected no count for Find, got %d", segment.Count)
+	}
+	segment, _ = segmentService.FindWithCount("de412cad4")
+	if segment.Count != 42 {
+		t.Errorf("Expected count 42 for FindWithCount, got %d", segment.Count)
+	}
+}
+
 type TestSegmentAPI struct {
 	t *testing.T
 }
 
-func (t TestSegmentAPI) list() (SegmentList, error) {
+func (t TestSegmentAPI) list(params segmentListParams) (SegmentList, error) {
+	if params.Type == "company" {
+		return SegmentList{Segments: []Segment{Segment{ID: "fe213dac5", Type: "segment", PersonType: "company"}}}, nil
+	}
 	return SegmentList{Segments: []Segment{Segment{ID: "de412cad4", Name: "My Tag"}}}, nil
 }
 
-func (t TestSegmentAPI) find(id string) (Segment, error) {
-	return Segment{ID: id}, nil
+func (t TestSegmentAPI) find(id string, params segmentFindParams) (Segment, error) {
+	segment := Segment{ID: ID(id)}
+	if params.IncludeCount {
+		segment.Count = 42
+	}
+	return segment, nil
+}
+
+type TestSegmentUserAPI struct {
+	TestUserAPI
+}
+
+func (t TestSegmentUserAPI) List(params UserListParams) (UserList, error) {
+	if params.SegmentID == "empty" {
+		return UserList{Pages: PageParams{Page: 1}}, nil
+	}
+	return UserList{Pages: params.PageParams, Users: []User{User{ID: "46adad3f09126dca"}}}, nil
 }
@@ -4,16 +4,19 @@ import "fmt"
 
 // SegmentService handles interactions with the API through a SegmentRepository.
 type SegmentService struct {
-	Repository SegmentRepository
+	Repository     SegmentRepository
+	UserRepository UserRepository
 }
 
 // Segment represents an Segment in Intercom.
 type Segment struct {
-	ID         string `json:"id,omitempty"`
+	ID         ID     `json:"id,omitempty"`
+	Type       string `json:"type,omitempty"`
 	Name       string `json:"name,omitempty"`
 	CreatedAt  int64  `json:"created_at,omitempty"`
 	UpdatedAt  int64  `json:"updated_at,omitempty"`
 	PersonType string `json:"person_type,omitempty"`
+	Count      int64  `json:"count,omitempty"`
 }
 
 // SegmentList, an object holding a list of Segments
@@ -23,12 +26,51 @@ type SegmentList struct {
 
 // List all Segments for the App
 func (t *SegmentService) List() (SegmentList, error) {
-	return t.Repository.list()
+	return t.Repository.list(segmentListParams{})
+}
+
+// ListCompanySegments lists all Company Segments for the App
+func (t *SegmentService) ListCompanySegments() (SegmentList, error) {
+	return t.Repository.list(segmentListParams{Type: "company"})
+}
+
+// ListContacts lists the members of a Segment, a page at a time.
+// A Segment with no members gives an empty page.
+func (t *SegmentService) ListContacts(segmentID string, params PageParams) (UserList, error) {
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return UserList{}, err
+	}
+	userList, err := t.UserRepository.List(UserListParams{PageParams: params, SegmentID: segmentID})
+	if err == nil && userList.Users == nil {
+		userList.Users = []User{}
+	}
+	return userList, err
 }
 
 // Find a particular Segment in the App
 func (t *SegmentService) Find(id string) (Segment, error) {
-	return t.Repository.find(id)
+	if id == "" {
+		return Segment{}, missing("Segment ID")
+	}
+	return t.Repository.find(id, segmentFindParams{})
+}
+
+// FindWithCount finds a particular Segment in the App, including its member Count.
+// Counting members makes the request slower, so prefer Find when the Count isn't needed.
+func (t *SegmentService) FindWithCount(id string) (Segment, error) {
+	if id == "" {
+		return Segment{}, missing("Segment ID")
+	}
+	return t.Repository.find(id, segmentFindParams{IncludeCount: true})
+}
+
+type segmentListParams struct {
+	Type string `url:"type,omitempty"`
+}
+
+type segmentFindParams struct {
+	IncludeCount bool `url:"include_count,omitempty"`
 }
 
 func (s Segment) String() string {
@@ -0,0 +1,261 @@
+package intercom
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Interfaces of each of the services, named after the Client field which implements them:
+// &ic.Users is a Users, &ic.Conversations is a Conversations, and so on.
+// Code which depends on these, rather than the services, can be given a mock in tests.
+
+// Admins is implemented by AdminService.
+type Admins interface {
+	List() (AdminList, error)
+	Read(adminID string) (Admin, error)
+	Me() (Admin, error)
+}
+
+// Articles is implemented by ArticleService.
+type Articles interface {
+	Find(id string) (Article, error)
+	List(params CursorParams) (ArticleList, error)
+	All(params CursorParams) *Pager[Article]
+	ListAll(fn func(Article) error) error
+	Search(phrase string, opts ArticleSearchOptions) (ArticleSearchResult, error)
+	Create(article *Article) (Article, error)
+	Update(id string, article *Article) (Article, error)
+	Delete(id string) error
+}
+
+// Collections is implemented by CollectionService.
+type Collections interface {
+	Find(id string) (Collection, error)
+	List(params PageParams) (CollectionList, error)
+	Create(collection *Collection) (Collection, error)
+	Update(id string, collection *Collection) (Collection, error)
+	Delete(id string) error
+}
+
+// Companies is implemented by CompanyService.
+type Companies interface {
+	FindByID(id string) (Company, error)
+	FindByCompanyID(companyID string) (Company, error)
+	FindByName(name string) (Company, error)
+	List(params PageParams) (CompanyList, error)
+	All(params PageParams) *Pager[Company]
+	ListBySegment(segmentID string, params PageParams) (CompanyList, error)
+	ListByTag(tagID string, params PageParams) (CompanyList, error)
+	Scroll(scrollParam string) (CompanyList, error)
+	Save(user *Company) (Company, error)
+}
+
+// Contacts is implemented by ContactService.
+type Contacts interface {
+	FindByID(id string) (Contact, error)
+	FindByUserID(userID string) (Contact, error)
+	List(params PageParams) (ContactList, error)
+	All(params PageParams) *Pager[Contact]
+	Scroll(scrollParam string) (ContactList, error)
+	ListByEmail(email string, params PageParams) (ContactList, error)
+	ListBySegment(segmentID string, params PageParams) (ContactList, error)
+	ListByTag(tagID string, params PageParams) (ContactList, error)
+	Create(contact *Contact) (Contact, error)
+	Update(contact *Contact) (Contact, error)
+	Convert(contact *Contact, user *User) (User, error)
+	Delete(contact *Contact) (Contact, error)
+}
+
+// Counts is implemented by CountService.
+type Counts interface {
+	AppCounts() (AppCounts, error)
+	ConversationCounts() (ConversationCounts, error)
+	ConversationCountsByAdmin() ([]AdminConversationCount, error)
+	UserCountsBySegment() ([]NamedCount, error)
+	UserCountsByTag() ([]NamedCount, error)
+	CompanyCountsBySegment() ([]NamedCount, error)
+	CompanyCountsByTag() ([]NamedCount, error)
+	CompanyUserCounts() ([]NamedCount, error)
+}
+
+// Conversations is implemented by ConversationService.
+type Conversations interface {
+	ListAll(pageParams PageParams) (ConversationList, error)
+	All(pageParams PageParams) *Pager[Conversation]
+	StreamAll(pageParams PageParams, fn func(Conversation) error) error
+	ListByAdmin(adminID string, orderBy string, sort string, state ConversationListState, pageParams PageParams) (ConversationList, error)
+	ListByUser(user *User, state ConversationListState, pageParams PageParams) (ConversationList, error)
+	Find(id string) (Conversation, error)
+	FindMany(ids []string, concurrency int) (map[string]Conversation, map[string]error)
+	MarkRead(id string) (Conversation, error)
+	Reply(id string, author MessagePerson, replyType ReplyType, body string) (Conversation, error)
+	ReplyWithAttachmentURLs(id string, author MessagePerson, replyType ReplyType, body string, attachmentURLs []string) (Conversation, error)
+	Assign(id string, assigner, assignee *Admin) (Conversation, error)
+	Open(id string, opener *Admin) (Conversation, error)
+	Close(id string, closer *Admin) (Conversation, error)
+}
+
+// DataAttributes is implemented by DataAttributeService.
+type DataAttributes interface {
+	List(model string, includeArchived bool) (DataAttributeList, error)
+	Create(attribute DataAttribute) (DataAttribute, error)
+	Update(id int64, attribute DataAttribute) (DataAttribute, error)
+	SetMessengerWritable(id int64, writable bool) (DataAttribute, error)
+	Archive(id int64) (DataAttribute, error)
+	Unarchive(id int64) (DataAttribute, error)
+}
+
+// Events is implemented by EventService.
+type Events interface {
+	Save(event *Event) error
+	List(user *User, params PageParams) (EventList, error)
+	SaveBulk(events []Event) (JobResponse, error)
+	Summaries(user *User) (EventSummaryList, error)
+	NewAsyncSender(opts AsyncSenderOptions) *AsyncSender
+}
+
+// Exports is implemented by ExportService.
+type Exports interface {
+	Create(createdAtAfter, createdAtBefore int64) (ExportJob, error)
+	Status(jobID string) (ExportJob, error)
+	Cancel(jobID string) (ExportJob, error)
+	Download(jobID string, w io.Writer) error
+	DownloadCSV(jobID string, w io.Writer) error
+}
+
+// HelpCenters is implemented by HelpCenterService.
+type HelpCenters interface {
+	List() (HelpCenterList, error)
+	Find(id string) (HelpCenter, error)
+}
+
+// Jobs is implemented by JobService.
+type Jobs interface {
+	NewUserJob(items ...*JobItem) (JobResponse, error)
+	NewEventJob(items ...*JobItem) (JobResponse, error)
+	AppendUsers(id string, items ...*JobItem) (JobResponse, error)
+	AppendEvents(id string, items ...*JobItem) (JobResponse, error)
+	Find(id string) (JobResponse, error)
+	Wait(ctx context.Context, id string, pollInterval time.Duration) (JobResponse, error)
+}
+
+// Messages is implemented by MessageService.
+type Messages interface {
+	Save(message *MessageRequest) (MessageResponse, error)
+	Conversation(message MessageResponse) (Conversation, error)
+	SendToMany(recipients []User, message MessageRequest, opts SendToManyOptions) []MessageResult
+}
+
+// NewsItems is implemented by NewsItemService.
+type NewsItems interface {
+	Find(id string) (NewsItem, error)
+	List(params PageParams) (NewsItemList, error)
+	Create(newsItem *NewsItem) (NewsItem, error)
+	Update(id string, newsItem *NewsItem) (NewsItem, error)
+	Delete(id string) error
+	ListNewsfeeds() (NewsfeedList, error)
+}
+
+// Notes is implemented by NoteService.
+type Notes interface {
+	New(user *User, author *Admin, body string) (Note, error)
+	List(user *User, params PageParams) (NoteList, error)
+	All(user *User, params PageParams) *Pager[Note]
+	ListAll(user *User, fn func(Note) error) error
+}
+
+// PhoneCallRedirects is implemented by PhoneCallRedirectService.
+type PhoneCallRedirects interface {
+	Create(phone string) (PhoneCallRedirect, error)
+}
+
+// Sections is implemented by SectionService.
+type Sections interface {
+	Find(id string) (Section, error)
+	List(params PageParams) (SectionList, error)
+	Create(section *Section) (Section, error)
+	Update(id string, section *Section) (Section, error)
+	Delete(id string) error
+}
+
+// Segments is implemented by SegmentService.
+type Segments interface {
+	List() (SegmentList, error)
+	ListCompanySegments() (SegmentList, error)
+	ListContacts(segmentID string, params PageParams) (UserList, error)
+	Find(id string) (Segment, error)
+	FindWithCount(id string) (Segment, error)
+}
+
+// SubscriptionTypes is implemented by SubscriptionTypeService.
+type SubscriptionTypes interface {
+	List() (SubscriptionTypeList, error)
+}
+
+// Subscriptions is implemented by SubscriptionService.
+type Subscriptions interface {
+	Create(subscription *Subscription) (Subscription, error)
+	Find(id string) (Subscription, error)
+	List() (SubscriptionList, error)
+	Update(subscription *Subscription) (Subscription, error)
+	Ping(id string) error
+	Sent(id string, params PageParams) (DeliveryList, error)
+	Errors(id string, params PageParams) (DeliveryList, error)
+	Delete(id string) (Subscription, error)
+}
+
+// Tags is implemented by TagService.
+type Tags interface {
+	List() (TagList, error)
+	FindByName(name string) (Tag, error)
+	FindByNameIgnoreCase(name string) (Tag, error)
+	Save(tag *Tag) (Tag, error)
+	Delete(id string) error
+	Tag(taggingList *TaggingList) (Tag, error)
+	TagCompanies(name string, companyIDs []string) (Tag, error)
+	TagCompaniesByID(name string, ids []string) (Tag, error)
+	TagLeads(name string, leadIDs []string) (Tag, error)
+	TagUsersAll(name string, users []Tagging) (TaggingBatchResult, error)
+	UntagUsers(name string, userIDs []string) (Tag, error)
+	UntagCompanies(name string, companyIDs []string) (Tag, error)
+}
+
+// Teams is implemented by TeamService.
+type Teams interface {
+	List() (TeamList, error)
+	Find(id string) (Team, error)
+	Admins(teamID string) ([]Admin, error)
+}
+
+// TicketTypes is implemented by TicketTypeService.
+type TicketTypes interface {
+	List() (TicketTypeList, error)
+	Find(id string) (TicketType, error)
+	Create(ticketType *TicketType) (TicketType, error)
+	CreateAttribute(ticketTypeID string, attribute *TicketTypeAttribute) (TicketTypeAttribute, error)
+}
+
+// Tickets is implemented by TicketService.
+type Tickets interface {
+	Create(ticketTypeID string, contacts []Customer, attributes map[string]interface{}) (Ticket, error)
+	Find(id string) (Ticket, error)
+	Update(id string, patch TicketPatch) (Ticket, error)
+	Search(query SearchQuery, params CursorParams) (TicketList, error)
+	Reply(id string, author MessagePerson, replyType ReplyType, body string, attachmentURLs []string) (TicketPart, error)
+}
+
+// Users is implemented by UserService.
+type Users interface {
+	FindByID(id string) (User, error)
+	FindByUserID(userID string) (User, error)
+	FindByEmail(email string) (User, error)
+	FindMany(ids []string, concurrency int) (map[string]User, map[string]error)
+	List(params PageParams) (UserList, error)
+	All(params PageParams) *Pager[User]
+	Scroll(scrollParam string) (UserList, error)
+	ListBySegment(segmentID string, params PageParams) (UserList, error)
+	ListByTag(tagID string, params PageParams) (UserList, error)
+	Save(user *User) (User, error)
+	Delete(id string) (User, error)
+}
@@ -0,0 +1,30 @@
+package intercom
+
+// Compile-time assertions that each service implements its interface.
+var (
+	_ Admins             = &AdminService{}
+	_ Articles           = &ArticleService{}
+	_ Collections        = &CollectionService{}
+	_ Companies          = &CompanyService{}
+	_ Contacts           = &ContactService{}
+	_ Counts             = &CountService{}
+	_ Conversations      = &ConversationService{}
+	_ DataAttributes     = &DataAttributeService{}
+	_ Events             = &EventService{}
+	_ Exports            = &ExportService{}
+	_ HelpCenters        = &HelpCenterService{}
+	_ Jobs               = &JobService{}
+	_ Messages           = &MessageService{}
+	_ NewsItems          = &NewsItemService{}
+	_ Notes              = &NoteService{}
+	_ PhoneCallRedirects = &PhoneCallRedirectService{}
+	_ Sections           = &SectionService{}
+	_ Segments           = &SegmentService{}
+	_ SubscriptionTypes  = &SubscriptionTypeService{}
+	_ Subscriptions      = &SubscriptionService{}
+	_ Tags               = &TagService{}
+	_ Teams              = &TeamService{}
+	_ TicketTypes        = &TicketTypeService{}
+	_ Tickets            = &TicketService{}
+	_ Users              = &UserService{}
+)
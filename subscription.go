@@ -0,0 +1,147 @@
+package intercom
+
+import "fmt"
+
+// SubscriptionService handles interactions with the API through a SubscriptionRepository.
+type SubscriptionService struct {
+	Repository SubscriptionRepository
+}
+
+// Subscription represents a webhook Subscription in Intercom.
+// Topics is the complete set of topics the Subscription receives.
+type Subscription struct {
+	Type        string                 `json:"type,omitempty"`
+	ID          string                 `json:"id,omitempty"`
+	ServiceType string                 `json:"service_type,omitempty"`
+	URL         string                 `json:"url,omitempty"`
+	Topics      []string               `json:"topics,omitempty"`
+	HubSecret   string                 `json:"hub_secret,omitempty"`
+	Active      bool                   `json:"active"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt   int64                  `json:"created_at,omitempty"`
+	UpdatedAt   int64                  `json:"updated_at,omitempty"`
+}
+
+// SubscriptionList holds a list of Subscriptions
+type SubscriptionList struct {
+	Subscriptions []Subscription `json:"items"`
+}
+
+// DeliveryList holds a page of Deliveries for a Subscription.
+type DeliveryList struct {
+	Pages      PageParams `json:"pages"`
+	Deliveries []Delivery `json:"items"`
+}
+
+// Delivery is a record of a Notification sent to a Subscription.
+type Delivery struct {
+	ID               string            `json:"id"`
+	Topic            string            `json:"topic"`
+	CreatedAt        int64             `json:"created_at"`
+	DeliveryAttempts int64             `json:"delivery_attempts"`
+	FirstSentAt      int64             `json:"first_sent_at"`
+	DeliveryStatus   string            `json:"delivery_status"`
+	Request          *DeliveryRequest  `json:"http_request"`
+	Response         *DeliveryResponse `json:"http_response"`
+}
+
+// DeliveryRequest is the request made for a Delivery.
+type DeliveryRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+// DeliveryResponse is the response received for a Delivery.
+type DeliveryResponse struct {
+	StatusCode int    `json:"status_code"`
+	Body       string `json:"body"`
+}
+
+// Create a new webhook Subscription. It is created active; Update it with Active unset to deactivate it.
+// Topics Intercom does not recognise are rejected by the API, and returned as an IntercomError.
+func (s *SubscriptionService) Create(subscription *Subscription) (Subscription, error) {
+	if err := subscription.validate(); err != nil {
+		return Subscription{}, err
+	}
+	return s.Repository.create(subscription)
+}
+
+// Find a Subscription by its ID.
+func (s *SubscriptionService) Find(id string) (Subscription, error) {
+	if id == "" {
+		return Subscription{}, missing("Subscription ID")
+	}
+	return s.Repository.find(id)
+}
+
+// List all Subscriptions for the App.
+func (s *SubscriptionService) List() (SubscriptionList, error) {
+	return s.Repository.list()
+}
+
+// Update a Subscription, deactivating it unless Active is set.
+// The Subscription's Topics replace its existing topics in a single request,
+// so include every topic that should remain subscribed.
+func (s *SubscriptionService) Update(subscription *Subscription) (Subscription, error) {
+	if subscription.ID == "" {
+		return Subscription{}, missing("Subscription ID")
+	}
+	if err := subscription.validate(); err != nil {
+		return Subscription{}, err
+	}
+	return s.Repository.update(subscription)
+}
+
+// Ping asks Intercom to send a test Notification, with topic "ping", to a Subscription.
+func (s *SubscriptionService) Ping(id string) error {
+	if id == "" {
+		return missing("Subscription ID")
+	}
+	return s.Repository.ping(id)
+}
+
+// Sent lists Notifications which were delivered to a Subscription.
+func (s *SubscriptionService) Sent(id string, params PageParams) (DeliveryList, error) {
+	if id == "" {
+		return DeliveryList{}, missing("Subscription ID")
+	}
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return DeliveryList{}, err
+	}
+	return s.Repository.deliveries(id, "sent", params)
+}
+
+// Errors lists Notifications which failed to be delivered to a Subscription.
+func (s *SubscriptionService) Errors(id string, params PageParams) (DeliveryList, error) {
+	if id == "" {
+		return DeliveryList{}, missing("Subscription ID")
+	}
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return DeliveryList{}, err
+	}
+	return s.Repository.deliveries(id, "error", params)
+}
+
+// Delete a Subscription by its ID.
+func (s *SubscriptionService) Delete(id string) (Subscription, error) {
+	if id == "" {
+		return Subscription{}, missing("Subscription ID")
+	}
+	return s.Repository.delete(id)
+}
+
+func (s Subscription) validate() error {
+	if s.URL == "" {
+		return missing("Subscription URL")
+	}
+	if len(s.Topics) == 0 {
+		return missing("Subscription Topics")
+	}
+	return nil
+}
+
+func (s Subscription) String() string {
+	return fmt.Sprintf("[intercom] subscription { id: %s, url: %s, topics: %v }", s.ID, s.URL, s.Topics)
+}
@@ -0,0 +1,115 @@
+package intercom
+
+import (
+	"fmt"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// SubscriptionRepository defines the interface for working with Subscriptions through the API.
+type SubscriptionRepository interface {
+	create(*Subscription) (Subscription, error)
+	find(id string) (Subscription, error)
+	list() (SubscriptionList, error)
+	update(*Subscription) (Subscription, error)
+	delete(id string) (Subscription, error)
+	ping(id string) error
+	deliveries(id string, feed string, params PageParams) (DeliveryList, error)
+}
+
+// SubscriptionAPI implements SubscriptionRepository
+type SubscriptionAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+type requestSubscription struct {
+	ServiceType string                 `json:"service_type"`
+	URL         string                 `json:"url"`
+	Topics      []string               `json:"topics"`
+	HubSecret   string                 `json:"hub_secret,omitempty"`
+	Active      *bool                  `json:"active,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (api SubscriptionAPI) create(subscription *Subscription) (Subscription, error) {
+	// false is Active's zero value, so isn't sent, leaving Intercom to create the Subscription active
+	var active *bool
+	if subscription.Active {
+		active = Bool(true)
+	}
+	return api.save("/subscriptions", subscription, active)
+}
+
+func (api SubscriptionAPI) find(id string) (Subscription, error) {
+	subscription := Subscription{}
+	uri := fmt.Sprintf("/subscriptions/%s", id)
+	data, err := api.httpClient.Get(uri, nil)
+	if err != nil {
+		return subscription, err
+	}
+	err = unmarshal(uri, data, &subscription)
+	return subscription, err
+}
+
+func (api SubscriptionAPI) list() (SubscriptionList, error) {
+	subscriptionList := SubscriptionList{}
+	data, err := api.httpClient.Get("/subscriptions", nil)
+	if err != nil {
+		return subscriptionList, err
+	}
+	err = unmarshal("/subscriptions", data, &subscriptionList)
+	return subscriptionList, err
+}
+
+func (api SubscriptionAPI) update(subscription *Subscription) (Subscription, error) {
+	return api.save(fmt.Sprintf("/subscriptions/%s", subscription.ID), subscription, Bool(subscription.Active))
+}
+
+func (api SubscriptionAPI) delete(id string) (Subscription, error) {
+	subscription := Subscription{}
+	uri := fmt.Sprintf("/subscriptions/%s", id)
+	data, err := api.httpClient.Delete(uri, nil)
+	if err != nil {
+		return subscription, err
+	}
+	err = unmarshalAccepted(uri, data, &subscription)
+	return subscription, err
+}
+
+func (api SubscriptionAPI) ping(id string) error {
+	_, err := api.httpClient.Post(fmt.Sprintf("/subscriptions/%s/ping", id), nil)
+	return err
+}
+
+func (api SubscriptionAPI) deliveries(id string, feed string, params PageParams) (DeliveryList, error) {
+	deliveryList := DeliveryList{}
+	uri := fmt.Sprintf("/subscriptions/%s/%s", id, feed)
+	data, err := api.httpClient.Get(uri, params)
+	if err != nil {
+		return deliveryList, err
+	}
+	err = unmarshal(uri, data, &deliveryList)
+	return deliveryList, err
+}
+
+func (api SubscriptionAPI) save(uri string, subscription *Subscription, active *bool) (Subscription, error) {
+	serviceType := subscription.ServiceType
+	if serviceType == "" {
+		serviceType = "web"
+	}
+	requestSubscription := requestSubscription{
+		ServiceType: serviceType,
+		URL:         subscription.URL,
+		Topics:      subscription.Topics,
+		HubSecret:   subscription.HubSecret,
+		Active:      active,
+		Metadata:    subscription.Metadata,
+	}
+	savedSubscription := Subscription{}
+	data, err := api.httpClient.Post(uri, &requestSubscription)
+	if err != nil {
+		return savedSubscription, err
+	}
+	err = unmarshal(uri, data, &savedSubscription)
+	return savedSubscription, err
+}
@@ -0,0 +1,154 @@
+package intercom
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+func TestAPIFindSubscription(t *testing.T) {
+	http := TestSubscriptionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/subscription.json", expectedURI: "/subscriptions/nsub_123456789"}
+	api := SubscriptionAPI{httpClient: &http}
+	subscription, err := api.find("nsub_123456789")
+	if err != nil {
+		t.Fatalf("Error finding subscription: %v", err)
+	}
+	if subscription.ID != "nsub_123456789" {
+		t.Errorf("Subscription ID was %s, expected nsub_123456789", subscription.ID)
+	}
+	if !subscription.Active || subscription.HubSecret != "s3cr3t" {
+		t.Errorf("Subscription was not parsed, got %v", subscription)
+	}
+	if subscription.Metadata["environment"] != "staging" {
+		t.Errorf("Subscription metadata was %v", subscription.Metadata)
+	}
+}
+
+func TestAPIListSubscriptions(t *testing.T) {
+	http := TestSubscriptionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/subscriptions.json", expectedURI: "/subscriptions"}
+	api := SubscriptionAPI{httpClient: &http}
+	subscriptionList, err := api.list()
+	if err != nil {
+		t.Fatalf("Error listing subscriptions: %v", err)
+	}
+	if len(subscriptionList.Subscriptions) != 1 || subscriptionList.Subscriptions[0].Topics[1] != "conversation.user.created" {
+		t.Errorf("Subscriptions were %v", subscriptionList.Subscriptions)
+	}
+}
+
+func TestAPIUpdateSubscription(t *testing.T) {
+	http := TestSubscriptionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/subscription.json", expectedURI: "/subscriptions/nsub_123456789"}
+	api := SubscriptionAPI{httpClient: &http}
+	api.update(&Subscription{ID: "nsub_123456789", URL: "https://example.com/webhooks", Topics: []string{"user.created"}})
+	req := http.lastRequest.(*requestSubscription)
+	if req.ServiceType != "web" {
+		t.Errorf("Service type was %s, expected web", req.ServiceType)
+	}
+	if len(req.Topics) != 1 || req.Topics[0] != "user.created" {
+		t.Errorf("Topics were %v, expected the full replacement set", req.Topics)
+	}
+}
+
+func TestAPIDeleteSubscription(t *testing.T) {
+	http := TestSubscriptionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/subscription.json", expectedURI: "/subscriptions/nsub_123456789"}
+	api := SubscriptionAPI{httpClient: &http}
+	subscription, _ := api.delete("nsub_123456789")
+	if subscription.ID != "nsub_123456789" {
+		t.Errorf("Subscription ID was %s, expected nsub_123456789", subscription.ID)
+	}
+}
+
+func TestAPICreateSubscriptionActive(t *testing.T) {
+	http := TestSubscriptionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/subscription.json", expectedURI: "/subscriptions"}
+	api := SubscriptionAPI{httpClient: &http}
+	api.create(&Subscription{URL: "https://example.com/webhooks", Topics: []string{"user.created"}})
+	b, _ := json.Marshal(http.lastRequest)
+	if strings.Contains(string(b), "active") {
+		t.Errorf("Request was %s, expected Intercom's default of active", b)
+	}
+
+	http.expectedURI = "/subscriptions/nsub_123456789"
+	api.update(&Subscription{ID: "nsub_123456789", URL: "https://example.com/webhooks", Topics: []string{"user.created"}})
+	if req := http.lastRequest.(*requestSubscription); req.Active == nil || *req.Active {
+		t.Errorf("Expected an update to send active false, got %v", req.Active)
+	}
+}
+
+func TestAPICreateSubscriptionInvalidTopic(t *testing.T) {
+	http := TestSubscriptionHTTPClient{t: t, expectedURI: "/subscriptions", err: interfaces.HTTPError{StatusCode: 422, Code: "parameter_invalid", Message: "Topic not recognised: user.exploded"}}
+	api := SubscriptionAPI{httpClient: &http}
+	_, err := api.create(&Subscription{URL: "https://example.com/webhooks", Topics: []string{"user.exploded"}})
+	intercomErr, ok := err.(IntercomError)
+	if !ok {
+		t.Fatalf("Error was %v, expected an IntercomError", err)
+	}
+	if intercomErr.GetCode() != "parameter_invalid" {
+		t.Errorf("Error code was %s, expected parameter_invalid", intercomErr.GetCode())
+	}
+}
+
+func TestAPIPingSubscription(t *testing.T) {
+	http := TestSubscriptionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/subscription.json", expectedURI: "/subscriptions/nsub_123456789/ping"}
+	api := SubscriptionAPI{httpClient: &http}
+	if err := api.ping("nsub_123456789"); err != nil {
+		t.Errorf("Error pinging subscription: %v", err)
+	}
+}
+
+func TestAPISubscriptionErrors(t *testing.T) {
+	http := TestSubscriptionHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/subscription_errors.json", expectedURI: "/subscriptions/nsub_123456789/error"}
+	api := SubscriptionAPI{httpClient: &http}
+	deliveryList, err := api.deliveries("nsub_123456789", "error", PageParams{Page: 2})
+	if err != nil {
+		t.Fatalf("Error listing deliveries: %v", err)
+	}
+	if http.lastQueryParams.(PageParams).Page != 2 {
+		t.Errorf("Page was not requested, got %v", http.lastQueryParams)
+	}
+	if deliveryList.Pages.TotalPages != 3 {
+		t.Errorf("Total pages was %d, expected 3", deliveryList.Pages.TotalPages)
+	}
+	delivery := deliveryList.Deliveries[0]
+	if delivery.Topic != "user.created" || delivery.Response.StatusCode != 502 || delivery.Request.Method != "POST" {
+		t.Errorf("Delivery was not parsed, got %v", delivery)
+	}
+}
+
+type TestSubscriptionHTTPClient struct {
+	TestHTTPClient
+	t               *testing.T
+	fixtureFilename string
+	expectedURI     string
+	lastRequest     interface{}
+	lastQueryParams interface{}
+	err             error
+}
+
+func (t *TestSubscriptionHTTPClient) Get(uri string, params interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	t.lastQueryParams = params
+	return ioutil.ReadFile(t.fixtureFilename)
+}
+
+func (t *TestSubscriptionHTTPClient) Post(uri string, body interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	t.lastRequest = body
+	if t.err != nil {
+		return nil, t.err
+	}
+	return ioutil.ReadFile(t.fixtureFilename)
+}
+
+func (t *TestSubscriptionHTTPClient) Delete(uri string, params interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	return ioutil.ReadFile(t.fixtureFilename)
+}
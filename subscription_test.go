@@ -0,0 +1,75 @@
+package intercom
+
+import "testing"
+
+func TestCreateSubscription(t *testing.T) {
+	subscriptionService := SubscriptionService{Repository: TestSubscriptionAPI{t: t}}
+	subscription, err := subscriptionService.Create(&Subscription{URL: "https://example.com/webhooks", Topics: []string{"user.created"}})
+	if err != nil {
+		t.Fatalf("Error creating subscription: %v", err)
+	}
+	if subscription.ID != "nsub_123456789" {
+		t.Errorf("Subscription ID was %s, expected nsub_123456789", subscription.ID)
+	}
+}
+
+func TestCreateSubscriptionMissingTopics(t *testing.T) {
+	subscriptionService := SubscriptionService{Repository: TestSubscriptionAPI{t: t}}
+	_, err := subscriptionService.Create(&Subscription{URL: "https://example.com/webhooks"})
+	if err == nil || err.Error() != "Missing Subscription Topics" {
+		t.Errorf("Expected Missing Subscription Topics, got %v", err)
+	}
+}
+
+func TestUpdateSubscriptionMissingID(t *testing.T) {
+	subscriptionService := SubscriptionService{Repository: TestSubscriptionAPI{t: t}}
+	_, err := subscriptionService.Update(&Subscription{URL: "https://example.com/webhooks", Topics: []string{"user.created"}})
+	if err == nil || err.Error() != "Missing Subscription ID" {
+		t.Errorf("Expected Missing Subscription ID, got %v", err)
+	}
+}
+
+func TestSubscriptionErrors(t *testing.T) {
+	subscriptionService := SubscriptionService{Repository: TestSubscriptionAPI{t: t}}
+	deliveryList, _ := subscriptionService.Errors("nsub_123456789", PageParams{Page: 2})
+	if deliveryList.Deliveries[0].Topic != "error" {
+		t.Errorf("Expected the error feed, got %s", deliveryList.Deliveries[0].Topic)
+	}
+	if deliveryList.Pages.Page != 2 {
+		t.Errorf("Expected page 2, got %d", deliveryList.Pages.Page)
+	}
+}
+
+type TestSubscriptionAPI struct {
+	t *testing.T
+}
+
+func (t TestSubscriptionAPI) create(subscription *Subscription) (Subscription, error) {
+	created := *subscription
+	created.ID = "nsub_123456789"
+	return created, nil
+}
+
+func (t TestSubscriptionAPI) find(id string) (Subscription, error) {
+	return Subscription{ID: id}, nil
+}
+
+func (t TestSubscriptionAPI) list() (SubscriptionList, error) {
+	return SubscriptionList{Subscriptions: []Subscription{Subscription{ID: "nsub_123456789"}}}, nil
+}
+
+func (t TestSubscriptionAPI) update(subscription *Subscription) (Subscription, error) {
+	return *subscription, nil
+}
+
+func (t TestSubscriptionAPI) delete(id string) (Subscription, error) {
+	return Subscription{ID: id}, nil
+}
+
+func (t TestSubscriptionAPI) ping(id string) error {
+	return nil
+}
+
+func (t TestSubscriptionAPI) deliveries(id string, feed string, params PageParams) (DeliveryList, error) {
+	return DeliveryList{Pages: params, Deliveries: []Delivery{Delivery{Topic: feed}}}, nil
+}
@@ -0,0 +1,52 @@
+package intercom
+
+import "fmt"
+
+// SubscriptionTypeService handles interactions with the API through a SubscriptionTypeRepository.
+type SubscriptionTypeService struct {
+	Repository SubscriptionTypeRepository
+}
+
+// SubscriptionType is a type of message Contacts can subscribe to, or unsubscribe from.
+// ConsentType is "opt_in" or "opt_out", and State is "live", "draft" or "archived".
+type SubscriptionType struct {
+	Type               string                        `json:"type"`
+	ID                 string                        `json:"id"`
+	State              string                        `json:"state"`
+	ConsentType        string                        `json:"consent_type"`
+	ContentTypes       []string                      `json:"content_types"`
+	DefaultTranslation SubscriptionTypeTranslation   `json:"default_translation"`
+	Translations       []SubscriptionTypeTranslation `json:"translations"`
+}
+
+// SubscriptionTypeTranslation is the name and description of a SubscriptionType in a locale.
+type SubscriptionTypeTranslation struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Locale      string `json:"locale"`
+}
+
+// SubscriptionTypeList holds a list of SubscriptionTypes
+type SubscriptionTypeList struct {
+	SubscriptionTypes []SubscriptionType `json:"data"`
+}
+
+// List all SubscriptionTypes for the App
+func (s *SubscriptionTypeService) List() (SubscriptionTypeList, error) {
+	return s.Repository.list()
+}
+
+// Translation returns the SubscriptionType's translation for a locale,
+// falling back to its DefaultTranslation.
+func (s SubscriptionType) Translation(locale string) SubscriptionTypeTranslation {
+	for _, translation := range s.Translations {
+		if translation.Locale == locale {
+			return translation
+		}
+	}
+	return s.DefaultTranslation
+}
+
+func (s SubscriptionType) String() string {
+	return fmt.Sprintf("[intercom] subscription_type { id: %s, name: %s }", s.ID, s.DefaultTranslation.Name)
+}
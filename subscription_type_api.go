@@ -0,0 +1,25 @@
+package intercom
+
+import (
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// SubscriptionTypeRepository defines the interface for working with SubscriptionTypes through the API.
+type SubscriptionTypeRepository interface {
+	list() (SubscriptionTypeList, error)
+}
+
+// SubscriptionTypeAPI implements SubscriptionTypeRepository
+type SubscriptionTypeAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+func (api SubscriptionTypeAPI) list() (SubscriptionTypeList, error) {
+	subscriptionTypeList := SubscriptionTypeList{}
+	data, err := api.httpClient.Get("/subscription_types", nil)
+	if err != nil {
+		return subscriptionTypeList, err
+	}
+	err = unmarshal("/subscription_types", data, &subscriptionTypeList)
+	return subscriptionTypeList, err
+}
@@ -0,0 +1,22 @@
+package intercom
+
+import "testing"
+
+func TestAPIListSubscriptionTypes(t *testing.T) {
+	http := TestTeamHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/subscription_types.json", expectedURI: "/subscription_types"}
+	api := SubscriptionTypeAPI{httpClient: &http}
+	subscriptionTypeList, err := api.list()
+	if err != nil {
+		t.Fatalf("Error listing subscription types: %v", err)
+	}
+	subscriptionType := subscriptionTypeList.SubscriptionTypes[0]
+	if subscriptionType.ID != "37" || subscriptionType.ConsentType != "opt_out" || subscriptionType.ContentTypes[0] != "email" {
+		t.Errorf("Subscription type was not parsed, got %v", subscriptionType)
+	}
+	if name := subscriptionType.Translation("fr").Name; name != "Lettres d'information" {
+		t.Errorf("French translation was %s", name)
+	}
+	if name := subscriptionType.Translation("de").Name; name != "Newsletters" {
+		t.Errorf("Missing translation should fall back to default, got %s", name)
+	}
+}
@@ -1,15 +1,47 @@
 package intercom
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// ErrTagNotFound is returned when looking up a Tag by name that does not exist.
+// It matches ErrNotFound with errors.Is, as a Tag not found by the API would.
+var ErrTagNotFound error = notFoundError("Tag Not Found")
+
+// notFoundError is an error for something found not to exist without asking the API, matching ErrNotFound.
+type notFoundError string
+
+func (e notFoundError) Error() string {
+	return string(e)
+}
+
+func (e notFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+const (
+	maxTaggingsPerRequest = 100
+	taggingConcurrency    = 4
+	maxTaggingRetries     = 5
+	taggingBackoff        = time.Second
+	maxTaggingBackoff     = time.Minute
+)
 
 // TagService handles interactions with the API through a TagRepository.
 type TagService struct {
 	Repository TagRepository
+
+	clock interfaces.Clock
 }
 
 // Tag represents an Tag in Intercom.
 type Tag struct {
-	ID   string `json:"id,omitempty"`
+	ID   ID     `json:"id,omitempty"`
 	Name string `json:"name,omitempty"`
 }
 
@@ -20,22 +52,184 @@ type TagList struct {
 
 // List all Tags for the App
 func (t *TagService) List() (TagList, error) {
-	return t.Repository.list()
+	return t.Repository.List()
+}
+
+// FindByName finds a Tag by its exact (case-sensitive) Name.
+func (t *TagService) FindByName(name string) (Tag, error) {
+	return t.findByName(func(tag Tag) bool { return tag.Name == name })
+}
+
+// FindByNameIgnoreCase finds a Tag by its Name, ignoring case.
+func (t *TagService) FindByNameIgnoreCase(name string) (Tag, error) {
+	return t.findByName(func(tag Tag) bool { return strings.EqualFold(tag.Name, name) })
+}
+
+func (t *TagService) findByName(match func(Tag) bool) (Tag, error) {
+	tagList, err := t.Repository.List()
+	if err != nil {
+		return Tag{}, err
+	}
+	for _, tag := range tagList.Tags {
+		if match(tag) {
+			return tag, nil
+		}
+	}
+	return Tag{}, ErrTagNotFound
 }
 
 // Save a new Tag for the App.
 func (t *TagService) Save(tag *Tag) (Tag, error) {
-	return t.Repository.save(tag)
+	return t.Repository.Save(tag)
 }
 
 // Delete a Tag
 func (t *TagService) Delete(id string) error {
-	return t.Repository.delete(id)
+	if id == "" {
+		return missing("Tag ID")
+	}
+	return t.Repository.Delete(id)
 }
 
 // Tag Users or Companies using a TaggingList.
 func (t *TagService) Tag(taggingList *TaggingList) (Tag, error) {
-	return t.Repository.tag(taggingList)
+	return t.Repository.Tag(taggingList)
+}
+
+// TagCompanies tags Companies by their CompanyID (customer-defined), returning the applied Tag.
+func (t *TagService) TagCompanies(name string, companyIDs []string) (Tag, error) {
+	taggings := make([]Tagging, len(companyIDs))
+	for i, companyID := range companyIDs {
+		taggings[i] = Tagging{CompanyID: companyID}
+	}
+	return t.Repository.Tag(&TaggingList{Name: name, Companies: taggings})
+}
+
+// TagCompaniesByID tags Companies by their Intercom ID, returning the applied Tag.
+func (t *TagService) TagCompaniesByID(name string, ids []string) (Tag, error) {
+	taggings := make([]Tagging, len(ids))
+	for i, id := range ids {
+		taggings[i] = Tagging{ID: id}
+	}
+	return t.Repository.Tag(&TaggingList{Name: name, Companies: taggings})
+}
+
+// TagLeads tags Leads (Contacts) by their Intercom ID, returning the applied Tag.
+// Leads are tagged through the users array, identified by id.
+func (t *TagService) TagLeads(name string, leadIDs []string) (Tag, error) {
+	taggings := make([]Tagging, len(leadIDs))
+	for i, leadID := range leadIDs {
+		taggings[i] = Tagging{ID: leadID}
+	}
+	return t.Repository.Tag(&TaggingList{Name: name, Users: taggings})
+}
+
+// TagUsersAll tags any number of Users, splitting them into requests of at most 100 Users
+// which are sent concurrently. Rate limited requests are retried, waiting as long as Retry-After says,
+// or else with exponential backoff, on top of any retries the Client makes.
+// If any request fails, an error is returned and the failures are detailed in the result.
+func (t *TagService) TagUsersAll(name string, users []Tagging) (TaggingBatchResult, error) {
+	chunks := chunkTaggings(users, maxTaggingsPerRequest)
+	result := TaggingBatchResult{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, taggingConcurrency)
+	for _, chunk := range chunks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(chunk []Tagging) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			tag, err := t.tagWithBackoff(&TaggingList{Name: name, Users: chunk})
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.Failed = append(result.Failed, TaggingFailure{Users: chunk, Err: err})
+				return
+			}
+			result.Tag = tag
+		}(chunk)
+	}
+	wg.Wait()
+	if len(result.Failed) > 0 {
+		return result, fmt.Errorf("%d of %d tagging requests failed", len(result.Failed), len(chunks))
+	}
+	return result, nil
+}
+
+func (t *TagService) tagWithBackoff(taggingList *TaggingList) (Tag, error) {
+	clock := clockOrReal(t.clock)
+	wait := taggingBackoff / 2
+	for attempt := 0; ; attempt++ {
+		tag, err := t.Repository.Tag(taggingList)
+		if err == nil || attempt >= maxTaggingRetries {
+			return tag, err
+		}
+		var rateLimited bool
+		if wait, rateLimited = rateLimitBackoff(err, clock.Now(), wait, maxTaggingBackoff); !rateLimited {
+			return tag, err
+		}
+		<-clock.After(wait)
+	}
+}
+
+// UntagUsers removes a Tag from Users by their UserID (customer supplied).
+func (t *TagService) UntagUsers(name string, userIDs []string) (Tag, error) {
+	taggings := make([]Tagging, len(userIDs))
+	for i, userID := range userIDs {
+		taggings[i] = Tagging{UserID: userID, Untag: Bool(true)}
+	}
+	return t.Repository.Tag(&TaggingList{Name: name, Users: taggings})
+}
+
+// UntagCompanies removes a Tag from Companies by their CompanyID (customer-defined).
+func (t *TagService) UntagCompanies(name string, companyIDs []string) (Tag, error) {
+	taggings := make([]Tagging, len(companyIDs))
+	for i, companyID := range companyIDs {
+		taggings[i] = Tagging{CompanyID: companyID, Untag: Bool(true)}
+	}
+	return t.Repository.Tag(&TaggingList{Name: name, Companies: taggings})
+}
+
+// Has reports whether the TagList contains a Tag with the given Name.
+// It is safe to call on a nil TagList.
+func (l *TagList) Has(name string) bool {
+	if l == nil {
+		return false
+	}
+	for _, tag := range l.Tags {
+		if tag.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HasID reports whether the TagList contains a Tag with the given ID.
+// It is safe to call on a nil TagList.
+func (l *TagList) HasID(id string) bool {
+	if l == nil {
+		return false
+	}
+	for _, tag := range l.Tags {
+		if tag.ID.String() == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Names returns the Names of the Tags in the TagList.
+// It is safe to call on a nil TagList.
+func (l *TagList) Names() []string {
+	if l == nil {
+		return nil
+	}
+	names := make([]string, len(l.Tags))
+	for i, tag := range l.Tags {
+		names[i] = tag.Name
+	}
+	return names
 }
 
 func (t Tag) String() string {
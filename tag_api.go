@@ -1,18 +1,23 @@
 package intercom
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"gopkg.in/intercom/intercom-go.v2/interfaces"
 )
 
 // TagRepository defines the interface for working with Tags through the API.
+// It can be implemented outside this package and set on the TagService;
+// intercomtest.RunTagRepositoryTests checks an implementation behaves as TagAPI does.
 type TagRepository interface {
-	list() (TagList, error)
-	save(tag *Tag) (Tag, error)
-	delete(id string) error
-	tag(tagList *TaggingList) (Tag, error)
+	// List returns every Tag.
+	List() (TagList, error)
+	// Save creates the Tag, or renames it if it has an ID, and returns it as saved.
+	Save(tag *Tag) (Tag, error)
+	// Delete deletes the Tag with the ID.
+	Delete(id string) error
+	// Tag tags, or untags, the Users or Companies in the TaggingList with its Name, creating the Tag if needed.
+	Tag(tagList *TaggingList) (Tag, error)
 }
 
 // TagAPI implements TagRepository
@@ -20,37 +25,37 @@ type TagAPI struct {
 	httpClient interfaces.HTTPClient
 }
 
-func (api TagAPI) list() (TagList, error) {
+func (api TagAPI) List() (TagList, error) {
 	tagList := TagList{}
 	data, err := api.httpClient.Get("/tags", nil)
 	if err != nil {
 		return tagList, err
 	}
-	err = json.Unmarshal(data, &tagList)
+	err = unmarshal("/tags", data, &tagList)
 	return tagList, err
 }
 
-func (api TagAPI) save(tag *Tag) (Tag, error) {
+func (api TagAPI) Save(tag *Tag) (Tag, error) {
 	savedTag := Tag{}
 	data, err := api.httpClient.Post("/tags", tag)
 	if err != nil {
 		return savedTag, err
 	}
-	err = json.Unmarshal(data, &savedTag)
+	err = unmarshal("/tags", data, &savedTag)
 	return savedTag, err
 }
 
-func (api TagAPI) delete(id string) error {
+func (api TagAPI) Delete(id string) error {
 	_, err := api.httpClient.Delete(fmt.Sprintf("/tags/%s", id), nil)
 	return err
 }
 
-func (api TagAPI) tag(taggingList *TaggingList) (Tag, error) {
+func (api TagAPI) Tag(taggingList *TaggingList) (Tag, error) {
 	savedTag := Tag{}
 	data, err := api.httpClient.Post("/tags", taggingList)
 	if err != nil {
 		return savedTag, err
 	}
-	err = json.Unmarshal(data, &savedTag)
+	err = unmarshal("/tags", data, &savedTag)
 	return savedTag, err
 }
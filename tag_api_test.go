@@ -13,19 +13,19 @@ type TestTagHTTPClient struct {
 }
 
 func TestAPIListTag(t *testing.T) {
-	http := TestTagHTTPClient{t: t, fixtureFilename: "fixtures/tags.json", expectedURI: "/tags"}
+	http := TestTagHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/tags.json", expectedURI: "/tags"}
 	api := TagAPI{httpClient: &http}
-	tagList, _ := api.list()
+	tagList, _ := api.List()
 	if tagList.Tags[0].ID != "51313" {
 		t.Errorf("Tag list should start with tag 51313, but had %s", tagList.Tags[0].ID)
 	}
 }
 
 func TestAPITagSave(t *testing.T) {
-	http := TestTagHTTPClient{t: t, fixtureFilename: "fixtures/tag.json", expectedURI: "/tags"}
+	http := TestTagHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/tag.json", expectedURI: "/tags"}
 	api := TagAPI{httpClient: &http}
 	tag := Tag{ID: "60218", Name: "My Tag"}
-	savedTag, _ := api.save(&tag)
+	savedTag, _ := api.Save(&tag)
 	if savedTag.ID != "60218" {
 		t.Errorf("Expected saved tag with ID 60218, got %s", savedTag.ID)
 	}
@@ -34,14 +34,14 @@ func TestAPITagSave(t *testing.T) {
 func TestAPITagDelete(t *testing.T) {
 	http := TestTagHTTPClient{t: t, expectedURI: "/tags/6"}
 	api := TagAPI{httpClient: &http}
-	api.delete("6")
+	api.Delete("6")
 }
 
 func TestAPITagTagging(t *testing.T) {
-	http := TestTagHTTPClient{t: t, fixtureFilename: "fixtures/tag.json", expectedURI: "/tags"}
+	http := TestTagHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/tag.json", expectedURI: "/tags"}
 	api := TagAPI{httpClient: &http}
 	taggingList := TaggingList{Name: "My Tag", Users: []Tagging{Tagging{UserID: "2345"}}}
-	savedTag, _ := api.tag(&taggingList)
+	savedTag, _ := api.Tag(&taggingList)
 	if savedTag.ID != "60218" {
 		t.Errorf("Expected saved tag with ID 60218, got %s", savedTag.ID)
 	}
@@ -1,6 +1,15 @@
 package intercom
 
-import "testing"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
 
 func TestListTags(t *testing.T) {
 	tagList, _ := (&TagService{Repository: TestTagAPI{t: t}}).List()
@@ -10,6 +19,32 @@ func TestListTags(t *testing.T) {
 	}
 }
 
+func TestFindTagByName(t *testing.T) {
+	tagService := TagService{Repository: TestTagAPI{t: t}}
+	tag, err := tagService.FindByName("My Tag")
+	if err != nil || tag.ID != "24" {
+		t.Errorf("Got tag with ID %s, expected 24 (%v)", tag.ID, err)
+	}
+	if _, err := tagService.FindByName("my tag"); err != ErrTagNotFound {
+		t.Errorf("Expected ErrTagNotFound for differently cased name, got %v", err)
+	}
+}
+
+func TestFindTagByNameIgnoreCase(t *testing.T) {
+	tagService := TagService{Repository: TestTagAPI{t: t}}
+	tag, err := tagService.FindByNameIgnoreCase("my TAG")
+	if err != nil || tag.ID != "24" {
+		t.Errorf("Got tag with ID %s, expected 24 (%v)", tag.ID, err)
+	}
+	_, err = tagService.FindByNameIgnoreCase("Other Tag")
+	if err != ErrTagNotFound {
+		t.Errorf("Expected ErrTagNotFound, got %v", err)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrTagNotFound to match ErrNotFound")
+	}
+}
+
 func TestSaveTag(t *testing.T) {
 	tagService := TagService{Repository: TestTagAPI{t: t}}
 	tag := Tag{ID: "24", Name: "My Tag"}
@@ -27,29 +62,199 @@ func TestTaggingUsers(t *testing.T) {
 	tagService.Tag(&taggingList)
 }
 
+func TestTaggingCompanies(t *testing.T) {
+	var sent *TaggingList
+	tagService := TagService{Repository: TestTagAPI{t: t, tagFunc: func(taggingList *TaggingList) { sent = taggingList }}}
+	tagService.TagCompanies("My Tag", []string{"c1", "c2"})
+	if sent.Name != "My Tag" {
+		t.Errorf("Tagging request expected to have Name My Tag but had %s", sent.Name)
+	}
+	if len(sent.Companies) != 2 || sent.Companies[1].CompanyID != "c2" {
+		t.Errorf("Tagging request expected to have companies c1, c2 but had %v", sent.Companies)
+	}
+	if len(sent.Users) != 0 {
+		t.Errorf("Tagging request expected to have no users but had %v", sent.Users)
+	}
+}
+
+func TestTaggingCompaniesByID(t *testing.T) {
+	var sent *TaggingList
+	tagService := TagService{Repository: TestTagAPI{t: t, tagFunc: func(taggingList *TaggingList) { sent = taggingList }}}
+	tagService.TagCompaniesByID("My Tag", []string{"5443ac9b"})
+	if sent.Companies[0].ID != "5443ac9b" || sent.Companies[0].CompanyID != "" {
+		t.Errorf("Tagging request expected to have company ID 5443ac9b but had %v", sent.Companies[0])
+	}
+}
+
+func TestTaggingLeads(t *testing.T) {
+	var sent *TaggingList
+	tagService := TagService{Repository: TestTagAPI{t: t, tagFunc: func(taggingList *TaggingList) { sent = taggingList }}}
+	tagService.TagLeads("Campaign", []string{"5811e1c5"})
+	b, _ := json.Marshal(sent)
+	if string(b) != `{"name":"Campaign","users":[{"id":"5811e1c5"}]}` {
+		t.Errorf("Lead tagging request had unexpected body %s", b)
+	}
+}
+
+func TestUntaggingUsers(t *testing.T) {
+	var sent *TaggingList
+	tagService := TagService{Repository: TestTagAPI{t: t, tagFunc: func(taggingList *TaggingList) { sent = taggingList }}}
+	tagService.UntagUsers("My Tag", []string{"245", "246"})
+	for _, tagging := range sent.Users {
+		if tagging.Untag == nil || *tagging.Untag != true {
+			t.Errorf("Untagging request expected to have untag set on %s", tagging.UserID)
+		}
+	}
+}
+
+func TestUntaggingCompanies(t *testing.T) {
+	var sent *TaggingList
+	tagService := TagService{Repository: TestTagAPI{t: t, tagFunc: func(taggingList *TaggingList) { sent = taggingList }}}
+	tagService.UntagCompanies("My Tag", []string{"c1"})
+	if sent.Companies[0].CompanyID != "c1" || sent.Companies[0].Untag == nil || *sent.Companies[0].Untag != true {
+		t.Errorf("Untagging request expected to have untag set on c1, had %v", sent.Companies[0])
+	}
+}
+
+func TestTaggingListUntagSerialisation(t *testing.T) {
+	taggingList := TaggingList{Name: "My Tag", Users: []Tagging{Tagging{UserID: "245"}, Tagging{UserID: "246", Untag: Bool(true)}}}
+	b, _ := json.Marshal(taggingList)
+	var sent struct {
+		Users []map[string]interface{} `json:"users"`
+	}
+	json.Unmarshal(b, &sent)
+	if _, ok := sent.Users[0]["untag"]; ok {
+		t.Errorf("Tagged user expected to have no untag flag, had %s", b)
+	}
+	if sent.Users[1]["untag"] != true {
+		t.Errorf("Untagged user expected to have untag flag, had %s", b)
+	}
+}
+
+func TestTagListHas(t *testing.T) {
+	user := User{Tags: &TagList{Tags: []Tag{Tag{ID: "24", Name: "My Tag"}, Tag{ID: "25", Name: "Other"}}}}
+	if !user.Tags.Has("Other") {
+		t.Errorf("Expected tag list to have Other")
+	}
+	if user.Tags.Has("other") {
+		t.Errorf("Expected tag list name match to be case-sensitive")
+	}
+	if !user.Tags.HasID("24") || user.Tags.HasID("26") {
+		t.Errorf("Expected tag list to have ID 24 and not 26")
+	}
+	names := user.Tags.Names()
+	if len(names) != 2 || names[0] != "My Tag" || names[1] != "Other" {
+		t.Errorf("Expected names [My Tag Other], got %v", names)
+	}
+}
+
+func TestTagListNil(t *testing.T) {
+	conversation := Conversation{}
+	if conversation.TagList.Has("My Tag") {
+		t.Errorf("Expected nil tag list not to have My Tag")
+	}
+	if conversation.TagList.HasID("24") {
+		t.Errorf("Expected nil tag list not to have ID 24")
+	}
+	if names := conversation.TagList.Names(); len(names) != 0 {
+		t.Errorf("Expected nil tag list to have no names, got %v", names)
+	}
+}
+
+func TestTagUsersAll(t *testing.T) {
+	users := make([]Tagging, 250)
+	for i := range users {
+		users[i] = Tagging{UserID: fmt.Sprintf("%d", i)}
+	}
+	repo := &TestTagBatchAPI{rateLimited: map[string]int{"100": 2}, failing: map[string]bool{"200": true}}
+	clock := &testClock{now: time.Unix(1500000000, 0)}
+	result, err := (&TagService{Repository: repo, clock: clock}).TagUsersAll("My Tag", users)
+	if err == nil {
+		t.Errorf("Expected an error for the failing request")
+	}
+	if repo.calls != 5 {
+		t.Errorf("Expected 3 requests plus 2 retries, got %d", repo.calls)
+	}
+	if len(clock.waits) != 2 || clock.waits[0] != taggingBackoff || clock.waits[1] != 30*time.Second {
+		t.Errorf("Expected the retries to back off by the clock, then wait as long as Retry-After said, got %v", clock.waits)
+	}
+	if result.Tag.Name != "My Tag" {
+		t.Errorf("Expected applied tag My Tag, got %s", result.Tag.Name)
+	}
+	if len(result.Failed) != 1 || len(result.Failed[0].Users) != 50 || result.Failed[0].Users[0].UserID != "200" {
+		t.Errorf("Expected the final request of 50 users to fail, got %v", result.Failed)
+	}
+}
+
+func TestTagUsersAllSuccess(t *testing.T) {
+	repo := &TestTagBatchAPI{}
+	result, err := (&TagService{Repository: repo}).TagUsersAll("My Tag", []Tagging{Tagging{UserID: "1"}})
+	if err != nil || len(result.Failed) != 0 {
+		t.Errorf("Expected no failures, got %v, %v", err, result.Failed)
+	}
+	if repo.calls != 1 {
+		t.Errorf("Expected 1 request, got %d", repo.calls)
+	}
+}
+
+type TestTagBatchAPI struct {
+	TestTagAPI
+	mu          sync.Mutex
+	calls       int
+	rateLimited map[string]int
+	failing     map[string]bool
+}
+
+func (t *TestTagBatchAPI) Tag(taggingList *TaggingList) (Tag, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.calls++
+	first := taggingList.Users[0].UserID
+	if len(taggingList.Users) > maxTaggingsPerRequest {
+		return Tag{}, fmt.Errorf("too many users in request: %d", len(taggingList.Users))
+	}
+	if t.rateLimited[first] > 0 {
+		t.rateLimited[first]--
+		rateLimited := interfaces.HTTPError{StatusCode: 429, Code: "rate_limit_exceeded"}
+		if t.rateLimited[first] == 0 {
+			return Tag{}, RateLimitError{HTTPError: rateLimited, RetryAfter: "30"}
+		}
+		return Tag{}, fmt.Errorf("tagging: %w", rateLimited)
+	}
+	if t.failing[first] {
+		return Tag{}, interfaces.HTTPError{StatusCode: 500, Code: "server_error"}
+	}
+	return Tag{ID: "24", Name: taggingList.Name}, nil
+}
+
 type TestTagAPI struct {
-	t *testing.T
+	t       *testing.T
+	tagFunc func(*TaggingList)
 }
 
-func (t TestTagAPI) list() (TagList, error) {
+func (t TestTagAPI) List() (TagList, error) {
 	return TagList{Tags: []Tag{Tag{ID: "24", Name: "My Tag"}}}, nil
 }
 
-func (t TestTagAPI) save(tag *Tag) (Tag, error) {
+func (t TestTagAPI) Save(tag *Tag) (Tag, error) {
 	if tag.ID != "24" {
 		t.t.Errorf("Saved tag expected to have ID 24 but has %s", tag.ID)
 	}
 	return *tag, nil
 }
 
-func (t TestTagAPI) delete(id string) error {
+func (t TestTagAPI) Delete(id string) error {
 	if id != "6" {
 		t.t.Errorf("Delete tag request expected to have ID 6, but has %s", id)
 	}
 	return nil
 }
 
-func (t TestTagAPI) tag(taggingList *TaggingList) (Tag, error) {
+func (t TestTagAPI) Tag(taggingList *TaggingList) (Tag, error) {
+	if t.tagFunc != nil {
+		t.tagFunc(taggingList)
+		return Tag{Name: taggingList.Name}, nil
+	}
 	if taggingList.Users[0].UserID != "245" {
 		t.t.Errorf("Tagging request expected to have UserID 245 but had %s", taggingList.Users[0].UserID)
 	}
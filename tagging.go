@@ -18,3 +18,27 @@ type Tagging struct {
 	CompanyID string `json:"company_id,omitempty"`
 	Untag     *bool  `json:"untag,omitempty"`
 }
+
+// TaggingBatchResult is the outcome of tagging many Users across several requests.
+// Tag is the applied Tag, and Failed lists any requests which could not be applied.
+type TaggingBatchResult struct {
+	Tag    Tag
+	Failed []TaggingFailure
+}
+
+// A TaggingFailure is a request within a batch which failed, along with the Users it held.
+type TaggingFailure struct {
+	Users []Tagging
+	Err   error
+}
+
+func chunkTaggings(taggings []Tagging, size int) [][]Tagging {
+	chunks := [][]Tagging{}
+	for size < len(taggings) {
+		taggings, chunks = taggings[size:], append(chunks, taggings[:size])
+	}
+	if len(taggings) > 0 {
+		chunks = append(chunks, taggings)
+	}
+	return chunks
+}
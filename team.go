@@ -0,0 +1,76 @@
+package intercom
+
+import "fmt"
+
+// TeamService handles interactions with the API through a TeamRepository.
+type TeamService struct {
+	Repository      TeamRepository
+	AdminRepository AdminRepository
+}
+
+// Team represents a Team of Admins in Intercom.
+type Team struct {
+	ID       ID     `json:"id"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	AdminIDs []ID   `json:"admin_ids"`
+}
+
+// TeamList, an object holding a list of Teams
+type TeamList struct {
+	Teams []Team `json:"teams"`
+}
+
+// List all Teams for the App
+func (t *TeamService) List() (TeamList, error) {
+	return t.Repository.list()
+}
+
+// Find a particular Team in the App
+func (t *TeamService) Find(id string) (Team, error) {
+	if id == "" {
+		return Team{}, missing("Team ID")
+	}
+	return t.Repository.find(id)
+}
+
+// Admins finds a Team and resolves its AdminIDs into Admins, preserving order.
+// Admins that no longer exist are skipped.
+func (t *TeamService) Admins(teamID string) ([]Admin, error) {
+	if teamID == "" {
+		return nil, missing("Team ID")
+	}
+	team, err := t.Repository.find(teamID)
+	if err != nil {
+		return nil, err
+	}
+	adminList, err := t.AdminRepository.list()
+	if err != nil {
+		return nil, err
+	}
+	adminsByID := make(map[string]Admin, len(adminList.Admins))
+	for _, admin := range adminList.Admins {
+		adminsByID[admin.ID.String()] = admin
+	}
+	admins := make([]Admin, 0, len(team.AdminIDs))
+	for _, id := range team.AdminIDs {
+		if admin, ok := adminsByID[id.String()]; ok {
+			admins = append(admins, admin)
+		}
+	}
+	return admins, nil
+}
+
+// MessageAddress gets the address for a Team, so Messages can be sent from its inbox.
+// The messages API only accepts Admins as senders, and teams are addressed as
+// Admins by their ID, so the address has type admin.
+func (t Team) MessageAddress() MessageAddress {
+	return MessageAddress{
+		Type: "admin",
+		ID:   t.ID.String(),
+	}
+}
+
+func (t Team) String() string {
+	return fmt.Sprintf("[intercom] team { id: %s, name: %s }", t.ID, t.Name)
+}
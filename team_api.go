@@ -0,0 +1,39 @@
+package intercom
+
+import (
+	"fmt"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// TeamRepository defines the interface for working with Teams through the API.
+type TeamRepository interface {
+	list() (TeamList, error)
+	find(id string) (Team, error)
+}
+
+// TeamAPI implements TeamRepository
+type TeamAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+func (api TeamAPI) list() (TeamList, error) {
+	teamList := TeamList{}
+	data, err := api.httpClient.Get("/teams", nil)
+	if err != nil {
+		return teamList, err
+	}
+	err = unmarshal("/teams", data, &teamList)
+	return teamList, err
+}
+
+func (api TeamAPI) find(id string) (Team, error) {
+	team := Team{}
+	uri := fmt.Sprintf("/teams/%s", id)
+	data, err := api.httpClient.Get(uri, nil)
+	if err != nil {
+		return team, err
+	}
+	err = unmarshal(uri, data, &team)
+	return team, err
+}
@@ -0,0 +1,44 @@
+package intercom
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestAPIListTeams(t *testing.T) {
+	http := TestTeamHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/teams.json", expectedURI: "/teams"}
+	api := TeamAPI{httpClient: &http}
+	teamList, err := api.list()
+	if err != nil {
+		t.Fatalf("Error listing teams: %v", err)
+	}
+	if teamList.Teams[0].ID != "814865" {
+		t.Errorf("Team list should start with team 814865, but had %s", teamList.Teams[0].ID)
+	}
+}
+
+func TestAPIFindTeam(t *testing.T) {
+	http := TestTeamHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/team.json", expectedURI: "/teams/814865"}
+	api := TeamAPI{httpClient: &http}
+	team, err := api.find("814865")
+	if err != nil {
+		t.Fatalf("Error finding team: %v", err)
+	}
+	if len(team.AdminIDs) != 2 || team.AdminIDs[0] != "2" {
+		t.Errorf("Team admin IDs were %v, expected [2 1]", team.AdminIDs)
+	}
+}
+
+type TestTeamHTTPClient struct {
+	TestHTTPClient
+	t               *testing.T
+	fixtureFilename string
+	expectedURI     string
+}
+
+func (t TestTeamHTTPClient) Get(uri string, params interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	return ioutil.ReadFile(t.fixtureFilename)
+}
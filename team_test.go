@@ -0,0 +1,62 @@
+package intercom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFindTeam(t *testing.T) {
+	team, _ := (&TeamService{Repository: TestTeamAPI{t: t}}).Find("814865")
+	if team.ID != "814865" {
+		t.Errorf("Got team with ID %s, expected 814865", team.ID)
+	}
+}
+
+func TestTeamAdmins(t *testing.T) {
+	teamService := TeamService{Repository: TestTeamAPI{t: t}, AdminRepository: TestTeamAdminAPI{}}
+	admins, err := teamService.Admins("814865")
+	if err != nil {
+		t.Fatalf("Error resolving team admins: %v", err)
+	}
+	if len(admins) != 2 {
+		t.Fatalf("Expected 2 admins, got %d", len(admins))
+	}
+	if admins[0].ID != "3" || admins[1].ID != "1" {
+		t.Errorf("Admins were not in team order, got %s, %s", admins[0].ID, admins[1].ID)
+	}
+}
+
+func TestTeamMessageAddress(t *testing.T) {
+	team := Team{ID: "814865", Name: "Support"}
+	message := NewInAppMessage(team, User{ID: "46adad3f09126dca"}, "hi there")
+	b, _ := json.Marshal(message.From)
+	if string(b) != `{"type":"admin","id":"814865"}` {
+		t.Errorf("Team sender serialised as %s", b)
+	}
+}
+
+type TestTeamAPI struct {
+	t *testing.T
+}
+
+func (t TestTeamAPI) list() (TeamList, error) {
+	return TeamList{Teams: []Team{Team{ID: "814865", Name: "Support"}}}, nil
+}
+
+func (t TestTeamAPI) find(id string) (Team, error) {
+	return Team{ID: ID(id), AdminIDs: []ID{"3", "99", "1"}}, nil
+}
+
+type TestTeamAdminAPI struct{}
+
+func (t TestTeamAdminAPI) list() (AdminList, error) {
+	return AdminList{Admins: []Admin{Admin{ID: "1"}, Admin{ID: "2"}, Admin{ID: "3"}}}, nil
+}
+
+func (t TestTeamAdminAPI) read(string) (Admin, error) {
+	return Admin{}, nil
+}
+
+func (t TestTeamAdminAPI) me() (Admin, error) {
+	return Admin{}, nil
+}
@@ -0,0 +1,169 @@
+package intercom
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Ticket states, for Ticket.TicketState and TicketPatch.State.
+const (
+	TicketStateSubmitted         = "submitted"
+	TicketStateInProgress        = "in_progress"
+	TicketStateWaitingOnCustomer = "waiting_on_customer"
+	TicketStateResolved          = "resolved"
+)
+
+// TicketService handles interactions with the API through a TicketRepository.
+type TicketService struct {
+	Repository TicketRepository
+}
+
+// Ticket represents a Ticket in Intercom.
+type Ticket struct {
+	Type             string                 `json:"type"`
+	ID               string                 `json:"id"`
+	TicketID         string                 `json:"ticket_id"`
+	Category         string                 `json:"category"`
+	TicketAttributes map[string]interface{} `json:"ticket_attributes"`
+	TicketState      string                 `json:"ticket_state"`
+	TicketType       TicketType             `json:"ticket_type"`
+	Contacts         TicketContactList      `json:"contacts"`
+	AdminAssigneeID  ID                     `json:"admin_assignee_id"`
+	TeamAssigneeID   ID                     `json:"team_assignee_id"`
+	Open             bool                   `json:"open"`
+	SnoozedUntil     int64                  `json:"snoozed_until"`
+	LinkedObjects    LinkedObjectList       `json:"linked_objects"`
+	CreatedAt        int64                  `json:"created_at"`
+	UpdatedAt        int64                  `json:"updated_at"`
+}
+
+// TicketList holds a page of Tickets and paging information
+type TicketList struct {
+	Pages      CursorPages `json:"pages"`
+	TotalCount int64       `json:"total_count"`
+	Tickets    []Ticket    `json:"tickets"`
+}
+
+// TicketContactList holds the Contacts a Ticket is for.
+type TicketContactList struct {
+	Contacts []Customer `json:"contacts"`
+}
+
+// LinkedObjectList holds the Conversations and Tickets linked to a Ticket.
+type LinkedObjectList struct {
+	LinkedObjects []LinkedObject `json:"data"`
+}
+
+// LinkedObject is a Conversation or Ticket linked to a Ticket.
+type LinkedObject struct {
+	Type     string `json:"type"`
+	ID       string `json:"id"`
+	Category string `json:"category,omitempty"`
+}
+
+// A TicketPart is a reply or note on a Ticket.
+type TicketPart struct {
+	Type        string         `json:"type"`
+	ID          string         `json:"id"`
+	PartType    string         `json:"part_type"`
+	Body        string         `json:"body"`
+	CreatedAt   int64          `json:"created_at"`
+	UpdatedAt   int64          `json:"updated_at"`
+	Author      MessageAddress `json:"author"`
+	Attachments []Attachment   `json:"attachments"`
+	Redacted    bool           `json:"redacted"`
+}
+
+// TicketPatch holds the changes to make to a Ticket in an Update. Unset fields are left unchanged.
+type TicketPatch struct {
+	State            string                 `json:"state,omitempty"`
+	Open             *bool                  `json:"open,omitempty"`
+	SnoozedUntil     int64                  `json:"snoozed_until,omitempty"`
+	TicketAttributes map[string]interface{} `json:"ticket_attributes,omitempty"`
+	Assignment       *TicketAssignment      `json:"assignment,omitempty"`
+}
+
+// TicketAssignment assigns a Ticket to an Admin or Team, on behalf of an Admin.
+type TicketAssignment struct {
+	AdminID    string `json:"admin_id"`
+	AssigneeID string `json:"assignee_id"`
+}
+
+// Create a Ticket of a TicketType for Contacts, with the TicketType's attributes.
+func (t *TicketService) Create(ticketTypeID string, contacts []Customer, attributes map[string]interface{}) (Ticket, error) {
+	if ticketTypeID == "" {
+		return Ticket{}, missing("Ticket Type")
+	}
+	if len(contacts) == 0 {
+		return Ticket{}, missing("Ticket Contacts")
+	}
+	return t.Repository.create(ticketTypeID, contacts, attributes)
+}
+
+// Find a Ticket by its ID.
+func (t *TicketService) Find(id string) (Ticket, error) {
+	if id == "" {
+		return Ticket{}, missing("Ticket ID")
+	}
+	return t.Repository.find(id)
+}
+
+// Update a Ticket's state, assignment or attributes.
+func (t *TicketService) Update(id string, patch TicketPatch) (Ticket, error) {
+	if id == "" {
+		return Ticket{}, missing("Ticket ID")
+	}
+	switch patch.State {
+	case "", TicketStateSubmitted, TicketStateInProgress, TicketStateWaitingOnCustomer, TicketStateResolved:
+	default:
+		return Ticket{}, fmt.Errorf("Invalid Ticket State %q", patch.State)
+	}
+	return t.Repository.update(id, &patch)
+}
+
+// Search for Tickets matching a SearchQuery. Pass Pages.Next.StartingAfter from
+// the previous TicketList as params.StartingAfter to get the next page.
+func (t *TicketService) Search(query SearchQuery, params CursorParams) (TicketList, error) {
+	if err := query.validate(); err != nil {
+		return TicketList{}, err
+	}
+	params, err := params.limit(MaxCursorPerPage)
+	if err != nil {
+		return TicketList{}, err
+	}
+	return t.Repository.search(newRequestSearch(query, params))
+}
+
+// Reply to a Ticket as an Admin or Contact, like ConversationService.ReplyWithAttachmentURLs.
+// Only CONVERSATION_COMMENT and CONVERSATION_NOTE replies can be made to Tickets,
+// and only Admins can leave notes.
+func (t *TicketService) Reply(id string, author MessagePerson, replyType ReplyType, body string, attachmentURLs []string) (TicketPart, error) {
+	if id == "" {
+		return TicketPart{}, missing("Ticket ID")
+	}
+	if !identified(author) {
+		return TicketPart{}, missing("Reply Author Identifier")
+	}
+	if replyType != CONVERSATION_COMMENT && replyType != CONVERSATION_NOTE {
+		return TicketPart{}, fmt.Errorf("Invalid Ticket Reply Type %s", replyType)
+	}
+	reply := newReply(author, replyType, body, attachmentURLs)
+	if replyType == CONVERSATION_NOTE && reply.Type != "admin" {
+		return TicketPart{}, errors.New("Ticket Notes Must Be From An Admin")
+	}
+	return t.Repository.reply(id, &reply)
+}
+
+// LinkedConversationID returns the ID of the Conversation linked to the Ticket, if there is one.
+func (t Ticket) LinkedConversationID() (string, bool) {
+	for _, linked := range t.LinkedObjects.LinkedObjects {
+		if linked.Type == "conversation" {
+			return linked.ID, true
+		}
+	}
+	return "", false
+}
+
+func (t Ticket) String() string {
+	return fmt.Sprintf("[intercom] ticket { id: %s, ticket_id: %s, state: %s }", t.ID, t.TicketID, t.TicketState)
+}
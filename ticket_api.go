@@ -0,0 +1,88 @@
+package intercom
+
+import (
+	"fmt"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// TicketRepository defines the interface for working with Tickets through the API.
+type TicketRepository interface {
+	create(ticketTypeID string, contacts []Customer, attributes map[string]interface{}) (Ticket, error)
+	find(id string) (Ticket, error)
+	update(id string, patch *TicketPatch) (Ticket, error)
+	search(search *requestSearch) (TicketList, error)
+	reply(id string, reply *Reply) (TicketPart, error)
+}
+
+// TicketAPI implements TicketRepository
+type TicketAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+type requestTicket struct {
+	TicketTypeID     string                 `json:"ticket_type_id"`
+	Contacts         []requestTicketContact `json:"contacts"`
+	TicketAttributes map[string]interface{} `json:"ticket_attributes,omitempty"`
+}
+
+type requestTicketContact struct {
+	ID string `json:"id"`
+}
+
+func (api TicketAPI) create(ticketTypeID string, contacts []Customer, attributes map[string]interface{}) (Ticket, error) {
+	ticket := requestTicket{TicketTypeID: ticketTypeID, TicketAttributes: attributes}
+	for _, contact := range contacts {
+		ticket.Contacts = append(ticket.Contacts, requestTicketContact{ID: contact.ID})
+	}
+	data, err := api.httpClient.Post("/tickets", &ticket)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return api.unmarshal("/tickets", data)
+}
+
+func (api TicketAPI) find(id string) (Ticket, error) {
+	uri := fmt.Sprintf("/tickets/%s", id)
+	data, err := api.httpClient.Get(uri, nil)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api TicketAPI) update(id string, patch *TicketPatch) (Ticket, error) {
+	uri := fmt.Sprintf("/tickets/%s", id)
+	data, err := put(api.httpClient, uri, patch)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return api.unmarshal(uri, data)
+}
+
+func (api TicketAPI) search(search *requestSearch) (TicketList, error) {
+	ticketList := TicketList{}
+	data, err := api.httpClient.Post("/tickets/search", search)
+	if err != nil {
+		return ticketList, err
+	}
+	err = unmarshal("/tickets/search", data, &ticketList)
+	return ticketList, err
+}
+
+func (api TicketAPI) reply(id string, reply *Reply) (TicketPart, error) {
+	ticketPart := TicketPart{}
+	uri := fmt.Sprintf("/tickets/%s/reply", id)
+	data, err := api.httpClient.Post(uri, reply)
+	if err != nil {
+		return ticketPart, err
+	}
+	err = unmarshal(uri, data, &ticketPart)
+	return ticketPart, err
+}
+
+func (api TicketAPI) unmarshal(uri string, data []byte) (Ticket, error) {
+	ticket := Ticket{}
+	err := unmarshal(uri, data, &ticket)
+	return ticket, err
+}
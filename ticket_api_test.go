@@ -0,0 +1,108 @@
+package intercom
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+)
+
+func TestAPIFindTicket(t *testing.T) {
+	http := TestTicketHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/ticket.json", expectedURI: "/tickets/494"}
+	api := TicketAPI{httpClient: &http}
+	ticket, err := api.find("494")
+	if err != nil {
+		t.Fatalf("Error finding ticket: %v", err)
+	}
+	if ticket.ID != "494" || ticket.TicketState != TicketStateInProgress || ticket.TicketType.Name != "Bug" {
+		t.Errorf("Ticket was not parsed, got %v", ticket)
+	}
+	if ticket.TicketAttributes["severity"] != "high" {
+		t.Errorf("Ticket attributes were %v", ticket.TicketAttributes)
+	}
+	if ticket.Contacts.Contacts[0].ID != "667d61108a68186f43bafe92" {
+		t.Errorf("Ticket contacts were %v", ticket.Contacts)
+	}
+	if id, ok := ticket.LinkedConversationID(); !ok || id != "503" {
+		t.Errorf("Linked conversation was %s, expected 503", id)
+	}
+}
+
+func TestAPICreateTicket(t *testing.T) {
+	http := TestTicketHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/ticket.json", expectedURI: "/tickets"}
+	api := TicketAPI{httpClient: &http}
+	api.create("1295", []Customer{Customer{Type: "contact", ID: "667d61108a68186f43bafe92"}}, map[string]interface{}{"_default_title_": "Checkout is broken"})
+	b, _ := json.Marshal(http.lastRequest)
+	expected := `{"ticket_type_id":"1295","contacts":[{"id":"667d61108a68186f43bafe92"}],"ticket_attributes":{"_default_title_":"Checkout is broken"}}`
+	if string(b) != expected {
+		t.Errorf("Request was %s, expected %s", b, expected)
+	}
+}
+
+func TestAPIUpdateTicket(t *testing.T) {
+	http := TestTicketHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/ticket.json", expectedURI: "/tickets/494"}
+	api := TicketAPI{httpClient: &http}
+	api.update("494", &TicketPatch{State: TicketStateInProgress, Assignment: &TicketAssignment{AdminID: "991267497", AssigneeID: "991267497"}})
+	b, _ := json.Marshal(http.lastRequest)
+	expected := `{"state":"in_progress","assignment":{"admin_id":"991267497","assignee_id":"991267497"}}`
+	if string(b) != expected {
+		t.Errorf("Request was %s, expected %s", b, expected)
+	}
+}
+
+func TestAPISearchTickets(t *testing.T) {
+	http := TestTicketHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/tickets.json", expectedURI: "/tickets/search"}
+	api := TicketAPI{httpClient: &http}
+	ticketList, err := api.search(newRequestSearch(Where("open", SearchEquals, true), CursorParams{}))
+	if err != nil {
+		t.Fatalf("Error searching tickets: %v", err)
+	}
+	if len(ticketList.Tickets) != 1 || ticketList.Tickets[0].ID != "494" {
+		t.Errorf("Tickets were %v", ticketList.Tickets)
+	}
+	if ticketList.Pages.Next == nil || ticketList.Pages.Next.StartingAfter != "WzE3MTk0OTMwMTMwMDAsNDk0XQ==" {
+		t.Errorf("Next cursor was not parsed, got %v", ticketList.Pages.Next)
+	}
+}
+
+func TestAPIReplyToTicket(t *testing.T) {
+	http := TestTicketHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/ticket_part.json", expectedURI: "/tickets/494/reply"}
+	api := TicketAPI{httpClient: &http}
+	ticketPart, err := api.reply("494", &Reply{Type: "admin", ReplyType: "comment", AdminID: "991267497", Body: "Fixed!"})
+	if err != nil {
+		t.Fatalf("Error replying to ticket: %v", err)
+	}
+	if ticketPart.ID != "134" || ticketPart.CreatedAt != 1719493065 || ticketPart.Author.ID != "991267497" {
+		t.Errorf("Ticket part was not parsed, got %v", ticketPart)
+	}
+}
+
+type TestTicketHTTPClient struct {
+	TestHTTPClient
+	t               *testing.T
+	fixtureFilename string
+	expectedURI     string
+	lastRequest     interface{}
+}
+
+func (t *TestTicketHTTPClient) Get(uri string, params interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	return ioutil.ReadFile(t.fixtureFilename)
+}
+
+func (t *TestTicketHTTPClient) Post(uri string, body interface{}) ([]byte, error) {
+	return t.save(uri, body)
+}
+
+func (t *TestTicketHTTPClient) Put(uri string, body interface{}) ([]byte, error) {
+	return t.save(uri, body)
+}
+
+func (t *TestTicketHTTPClient) save(uri string, body interface{}) ([]byte, error) {
+	if uri != t.expectedURI {
+		t.t.Errorf("URI was %s, expected %s", uri, t.expectedURI)
+	}
+	t.lastRequest = body
+	return ioutil.ReadFile(t.fixtureFilename)
+}
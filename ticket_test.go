@@ -0,0 +1,78 @@
+package intercom
+
+import "testing"
+
+func TestCreateTicketMissingContacts(t *testing.T) {
+	ticketService := TicketService{Repository: &TestTicketAPI{}}
+	if _, err := ticketService.Create("1295", nil, nil); err == nil {
+		t.Errorf("Expected an error for missing contacts")
+	}
+}
+
+func TestUpdateTicketInvalidState(t *testing.T) {
+	ticketService := TicketService{Repository: &TestTicketAPI{}}
+	if _, err := ticketService.Update("494", TicketPatch{State: "closed"}); err == nil {
+		t.Errorf("Expected an error for an invalid state")
+	}
+	ticket, err := ticketService.Update("494", TicketPatch{State: TicketStateResolved})
+	if err != nil || ticket.TicketState != TicketStateResolved {
+		t.Errorf("Ticket was not resolved, got %v, %v", ticket, err)
+	}
+}
+
+func TestSearchTickets(t *testing.T) {
+	api := &TestTicketAPI{}
+	ticketService := TicketService{Repository: api}
+	ticketService.Search(Where("open", SearchEquals, true), CursorParams{StartingAfter: "WzE3MTk0OTMwMTMwMDAsNDk0XQ=="})
+	if api.lastSearch.Pagination == nil || api.lastSearch.Pagination.StartingAfter != "WzE3MTk0OTMwMTMwMDAsNDk0XQ==" {
+		t.Errorf("Cursor was not sent, got %v", api.lastSearch.Pagination)
+	}
+	if _, err := ticketService.Search(SearchQuery{}, CursorParams{}); err == nil {
+		t.Errorf("Expected an error for an empty query")
+	}
+}
+
+func TestReplyToTicket(t *testing.T) {
+	api := &TestTicketAPI{}
+	ticketService := TicketService{Repository: api}
+	_, err := ticketService.Reply("494", &Admin{ID: "991267497"}, CONVERSATION_NOTE, "Looking into it", nil)
+	if err != nil {
+		t.Fatalf("Error replying to ticket: %v", err)
+	}
+	if api.lastReply.AdminID != "991267497" || api.lastReply.ReplyType != "note" {
+		t.Errorf("Reply was %v", api.lastReply)
+	}
+	if _, err := ticketService.Reply("494", &Admin{ID: "991267497"}, CONVERSATION_CLOSE, "", nil); err == nil {
+		t.Errorf("Expected an error for a close reply")
+	}
+	if _, err := ticketService.Reply("494", &User{ID: "667d61108a68186f43bafe92"}, CONVERSATION_NOTE, "Note", nil); err == nil {
+		t.Errorf("Expected an error for a note from a user")
+	}
+}
+
+type TestTicketAPI struct {
+	lastSearch *requestSearch
+	lastReply  *Reply
+}
+
+func (t *TestTicketAPI) create(ticketTypeID string, contacts []Customer, attributes map[string]interface{}) (Ticket, error) {
+	return Ticket{TicketType: TicketType{ID: ticketTypeID}}, nil
+}
+
+func (t *TestTicketAPI) find(id string) (Ticket, error) {
+	return Ticket{ID: id}, nil
+}
+
+func (t *TestTicketAPI) update(id string, patch *TicketPatch) (Ticket, error) {
+	return Ticket{ID: id, TicketState: patch.State}, nil
+}
+
+func (t *TestTicketAPI) search(search *requestSearch) (TicketList, error) {
+	t.lastSearch = search
+	return TicketList{}, nil
+}
+
+func (t *TestTicketAPI) reply(id string, reply *Reply) (TicketPart, error) {
+	t.lastReply = reply
+	return TicketPart{PartType: reply.ReplyType}, nil
+}
@@ -0,0 +1,141 @@
+package intercom
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// TicketTypeAttributeDataType is the type of value a TicketTypeAttribute holds.
+type TicketTypeAttributeDataType string
+
+// The data types of TicketTypeAttributes.
+const (
+	TicketAttributeString   TicketTypeAttributeDataType = "string"
+	TicketAttributeList     TicketTypeAttributeDataType = "list"
+	TicketAttributeInteger  TicketTypeAttributeDataType = "integer"
+	TicketAttributeDecimal  TicketTypeAttributeDataType = "decimal"
+	TicketAttributeBoolean  TicketTypeAttributeDataType = "boolean"
+	TicketAttributeDatetime TicketTypeAttributeDataType = "datetime"
+	TicketAttributeFiles    TicketTypeAttributeDataType = "files"
+)
+
+// TicketTypeService handles interactions with the API through a TicketTypeRepository.
+type TicketTypeService struct {
+	Repository TicketTypeRepository
+}
+
+// TicketType represents a type of Ticket, which defines its attributes.
+// Category is one of "Customer", "Back-office" or "Tracker".
+type TicketType struct {
+	Type        string                  `json:"type,omitempty"`
+	ID          string                  `json:"id,omitempty"`
+	Name        string                  `json:"name,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Icon        string                  `json:"icon,omitempty"`
+	Category    string                  `json:"category,omitempty"`
+	Archived    bool                    `json:"archived,omitempty"`
+	Attributes  TicketTypeAttributeList `json:"ticket_type_attributes"`
+	CreatedAt   int64                   `json:"created_at,omitempty"`
+	UpdatedAt   int64                   `json:"updated_at,omitempty"`
+}
+
+// TicketTypeList holds a list of TicketTypes
+type TicketTypeList struct {
+	TicketTypes []TicketType `json:"data"`
+}
+
+// TicketTypeAttributeList holds the attributes of a TicketType.
+type TicketTypeAttributeList struct {
+	Attributes []TicketTypeAttribute `json:"data"`
+}
+
+// TicketTypeAttribute is an attribute which Tickets of a TicketType have.
+type TicketTypeAttribute struct {
+	Type                        string                          `json:"type,omitempty"`
+	ID                          string                          `json:"id,omitempty"`
+	TicketTypeID                json.Number                     `json:"ticket_type_id,omitempty"`
+	Name                        string                          `json:"name,omitempty"`
+	Description                 string                          `json:"description,omitempty"`
+	DataType                    TicketTypeAttributeDataType     `json:"data_type,omitempty"`
+	InputOptions                TicketTypeAttributeInputOptions `json:"input_options"`
+	Order                       int64                           `json:"order,omitempty"`
+	RequiredToCreate            bool                            `json:"required_to_create"`
+	RequiredToCreateForContacts bool                            `json:"required_to_create_for_contacts"`
+	VisibleOnCreate             bool                            `json:"visible_on_create"`
+	VisibleToContacts           bool                            `json:"visible_to_contacts"`
+	Default                     bool                            `json:"default"`
+	Archived                    bool                            `json:"archived"`
+	CreatedAt                   int64                           `json:"created_at,omitempty"`
+	UpdatedAt                   int64                           `json:"updated_at,omitempty"`
+}
+
+// TicketTypeAttributeInputOptions configure how a TicketTypeAttribute is entered.
+// ListOptions are the choices for list attributes, Multiline applies to strings,
+// and AllowMultipleValues to files.
+type TicketTypeAttributeInputOptions struct {
+	ListOptions         []TicketTypeAttributeListOption `json:"list_options,omitempty"`
+	Multiline           bool                            `json:"multiline,omitempty"`
+	AllowMultipleValues bool                            `json:"allow_multiple_values,omitempty"`
+}
+
+// TicketTypeAttributeListOption is a choice for a list TicketTypeAttribute.
+type TicketTypeAttributeListOption struct {
+	ID    string `json:"id,omitempty"`
+	Label string `json:"label"`
+}
+
+// List all TicketTypes for the App
+func (t *TicketTypeService) List() (TicketTypeList, error) {
+	return t.Repository.list()
+}
+
+// Find a TicketType, including its Attributes, by its ID.
+func (t *TicketTypeService) Find(id string) (TicketType, error) {
+	if id == "" {
+		return TicketType{}, missing("Ticket Type ID")
+	}
+	return t.Repository.find(id)
+}
+
+// Create a TicketType, which needs a Name.
+func (t *TicketTypeService) Create(ticketType *TicketType) (TicketType, error) {
+	if ticketType.Name == "" {
+		return TicketType{}, missing("Ticket Type Name")
+	}
+	return t.Repository.create(ticketType)
+}
+
+// CreateAttribute adds an attribute to a TicketType. It needs a Name and DataType,
+// and list attributes need ListOptions, which may only be given Labels.
+func (t *TicketTypeService) CreateAttribute(ticketTypeID string, attribute *TicketTypeAttribute) (TicketTypeAttribute, error) {
+	if ticketTypeID == "" {
+		return TicketTypeAttribute{}, missing("Ticket Type ID")
+	}
+	if attribute.Name == "" || attribute.DataType == "" {
+		return TicketTypeAttribute{}, missing("Ticket Type Attribute Name or Data Type")
+	}
+	if attribute.DataType == TicketAttributeList && len(attribute.InputOptions.ListOptions) == 0 {
+		return TicketTypeAttribute{}, missing("Ticket Type Attribute List Options")
+	}
+	for _, option := range attribute.InputOptions.ListOptions {
+		if strings.Contains(option.Label, ",") {
+			return TicketTypeAttribute{}, fmt.Errorf("List Option %q Must Not Contain Commas", option.Label)
+		}
+	}
+	return t.Repository.createAttribute(ticketTypeID, attribute)
+}
+
+// Attribute finds a TicketTypeAttribute of the TicketType by its Name.
+func (t TicketType) Attribute(name string) (TicketTypeAttribute, bool) {
+	for _, attribute := range t.Attributes.Attributes {
+		if attribute.Name == name {
+			return attribute, true
+		}
+	}
+	return TicketTypeAttribute{}, false
+}
+
+func (t TicketType) String() string {
+	return fmt.Sprintf("[intercom] ticket_type { id: %s, name: %s }", t.ID, t.Name)
+}
@@ -0,0 +1,105 @@
+package intercom
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/intercom/intercom-go.v2/interfaces"
+)
+
+// TicketTypeRepository defines the interface for working with TicketTypes through the API.
+type TicketTypeRepository interface {
+	list() (TicketTypeList, error)
+	find(id string) (TicketType, error)
+	create(*TicketType) (TicketType, error)
+	createAttribute(ticketTypeID string, attribute *TicketTypeAttribute) (TicketTypeAttribute, error)
+}
+
+// TicketTypeAPI implements TicketTypeRepository
+type TicketTypeAPI struct {
+	httpClient interfaces.HTTPClient
+}
+
+type requestTicketType struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+	Category    string `json:"category,omitempty"`
+}
+
+type requestTicketTypeAttribute struct {
+	Name                        string                      `json:"name"`
+	Description                 string                      `json:"description,omitempty"`
+	DataType                    TicketTypeAttributeDataType `json:"data_type"`
+	RequiredToCreate            bool                        `json:"required_to_create"`
+	RequiredToCreateForContacts bool                        `json:"required_to_create_for_contacts"`
+	VisibleOnCreate             bool                        `json:"visible_on_create"`
+	VisibleToContacts           bool                        `json:"visible_to_contacts"`
+	Multiline                   bool                        `json:"multiline,omitempty"`
+	ListItems                   string                      `json:"list_items,omitempty"`
+	AllowMultipleValues         bool                        `json:"allow_multiple_values,omitempty"`
+}
+
+func (api TicketTypeAPI) list() (TicketTypeList, error) {
+	ticketTypeList := TicketTypeList{}
+	data, err := api.httpClient.Get("/ticket_types", nil)
+	if err != nil {
+		return ticketTypeList, err
+	}
+	err = unmarshal("/ticket_types", data, &ticketTypeList)
+	return ticketTypeList, err
+}
+
+func (api TicketTypeAPI) find(id string) (TicketType, error) {
+	ticketType := TicketType{}
+	uri := fmt.Sprintf("/ticket_types/%s", id)
+	data, err := api.httpClient.Get(uri, nil)
+	if err != nil {
+		return ticketType, err
+	}
+	err = unmarshal(uri, data, &ticketType)
+	return ticketType, err
+}
+
+func (api TicketTypeAPI) create(ticketType *TicketType) (TicketType, error) {
+	requestTicketType := requestTicketType{
+		Name:        ticketType.Name,
+		Description: ticketType.Description,
+		Icon:        ticketType.Icon,
+		Category:    ticketType.Category,
+	}
+	savedTicketType := TicketType{}
+	data, err := api.httpClient.Post("/ticket_types", &requestTicketType)
+	if err != nil {
+		return savedTicketType, err
+	}
+	err = unmarshal("/ticket_types", data, &savedTicketType)
+	return savedTicketType, err
+}
+
+func (api TicketTypeAPI) createAttribute(ticketTypeID string, attribute *TicketTypeAttribute) (TicketTypeAttribute, error) {
+	labels := make([]string, len(attribute.InputOptions.ListOptions))
+	for i, option := range attribute.InputOptions.ListOptions {
+		labels[i] = option.Label
+	}
+	requestAttribute := requestTicketTypeAttribute{
+		Name:                        attribute.Name,
+		Description:                 attribute.Description,
+		DataType:                    attribute.DataType,
+		RequiredToCreate:            attribute.RequiredToCreate,
+		RequiredToCreateForContacts: attribute.RequiredToCreateForContacts,
+		VisibleOnCreate:             attribute.VisibleOnCreate,
+		VisibleToContacts:           attribute.VisibleToContacts,
+		Multiline:                   attribute.InputOptions.Multiline,
+		ListItems:                   strings.Join(labels, ","),
+		AllowMultipleValues:         attribute.InputOptions.AllowMultipleValues,
+	}
+	savedAttribute := TicketTypeAttribute{}
+	uri := fmt.Sprintf("/ticket_types/%s/attributes", ticketTypeID)
+	data, err := api.httpClient.Post(uri, &requestAttribute)
+	if err != nil {
+		return savedAttribute, err
+	}
+	err = unmarshal(uri, data, &savedAttribute)
+	return savedAttribute, err
+}
@@ -0,0 +1,40 @@
+package intercom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAPIFindTicketType(t *testing.T) {
+	http := TestTicketHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/ticket_type.json", expectedURI: "/ticket_types/1295"}
+	api := TicketTypeAPI{httpClient: &http}
+	ticketType, err := api.find("1295")
+	if err != nil {
+		t.Fatalf("Error finding ticket type: %v", err)
+	}
+	severity, ok := ticketType.Attribute("severity")
+	if !ok {
+		t.Fatalf("Ticket type did not have severity attribute")
+	}
+	if severity.DataType != TicketAttributeList || !severity.RequiredToCreate || severity.TicketTypeID != "1295" {
+		t.Errorf("Attribute was not parsed, got %v", severity)
+	}
+	if len(severity.InputOptions.ListOptions) != 2 || severity.InputOptions.ListOptions[1].Label != "high" {
+		t.Errorf("List options were %v", severity.InputOptions.ListOptions)
+	}
+}
+
+func TestAPICreateTicketTypeAttribute(t *testing.T) {
+	http := TestTicketHTTPClient{t: t, fixtureFilename: "intercomtest/fixtures/ticket_type.json", expectedURI: "/ticket_types/1295/attributes"}
+	api := TicketTypeAPI{httpClient: &http}
+	api.createAttribute("1295", &TicketTypeAttribute{
+		Name:         "severity",
+		DataType:     TicketAttributeList,
+		InputOptions: TicketTypeAttributeInputOptions{ListOptions: []TicketTypeAttributeListOption{{Label: "low"}, {Label: "high"}}},
+	})
+	b, _ := json.Marshal(http.lastRequest)
+	expected := `{"name":"severity","data_type":"list","required_to_create":false,"required_to_create_for_contacts":false,"visible_on_create":false,"visible_to_contacts":false,"list_items":"low,high"}`
+	if string(b) != expected {
+		t.Errorf("Request was %s, expected %s", b, expected)
+	}
+}
@@ -0,0 +1,41 @@
+package intercom
+
+import "testing"
+
+func TestCreateTicketTypeAttributeListOptions(t *testing.T) {
+	ticketTypeService := TicketTypeService{Repository: TestTicketTypeAPI{}}
+	_, err := ticketTypeService.CreateAttribute("1295", &TicketTypeAttribute{Name: "severity", DataType: TicketAttributeList})
+	if err == nil {
+		t.Errorf("Expected an error for a list attribute without options")
+	}
+	_, err = ticketTypeService.CreateAttribute("1295", &TicketTypeAttribute{
+		Name:         "severity",
+		DataType:     TicketAttributeList,
+		InputOptions: TicketTypeAttributeInputOptions{ListOptions: []TicketTypeAttributeListOption{{Label: "low, really"}}},
+	})
+	if err == nil {
+		t.Errorf("Expected an error for a list option containing a comma")
+	}
+	_, err = ticketTypeService.CreateAttribute("1295", &TicketTypeAttribute{Name: "reproducible", DataType: TicketAttributeBoolean})
+	if err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+type TestTicketTypeAPI struct{}
+
+func (t TestTicketTypeAPI) list() (TicketTypeList, error) {
+	return TicketTypeList{}, nil
+}
+
+func (t TestTicketTypeAPI) find(id string) (TicketType, error) {
+	return TicketType{ID: id}, nil
+}
+
+func (t TestTicketTypeAPI) create(ticketType *TicketType) (TicketType, error) {
+	return *ticketType, nil
+}
+
+func (t TestTicketTypeAPI) createAttribute(ticketTypeID string, attribute *TicketTypeAttribute) (TicketTypeAttribute, error) {
+	return *attribute, nil
+}
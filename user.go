@@ -85,7 +85,8 @@ type UserAvatar struct {
 	ImageURL string `json:"image_url,omitempty"`
 }
 
-type userListParams struct {
+// UserListParams are the query parameters for listing Users.
+type UserListParams struct {
 	PageParams
 	SegmentID string `url:"segment_id,omitempty"`
 	TagID     string `url:"tag_id,omitempty"`
@@ -97,6 +98,9 @@ type scrollParams struct {
 
 // FindByID looks up a User by their Intercom ID.
 func (u *UserService) FindByID(id string) (User, error) {
+	if id == "" {
+		return User{}, missing("User ID")
+	}
 	return u.findWithIdentifiers(UserIdentifiers{ID: id})
 }
 
@@ -111,36 +115,63 @@ func (u *UserService) FindByEmail(email string) (User, error) {
 }
 
 func (u *UserService) findWithIdentifiers(identifiers UserIdentifiers) (User, error) {
-	return u.Repository.find(identifiers)
+	return u.Repository.Find(identifiers)
 }
 
 // List all Users for App.
 func (u *UserService) List(params PageParams) (UserList, error) {
-	return u.Repository.list(userListParams{PageParams: params})
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return UserList{}, err
+	}
+	return u.Repository.List(UserListParams{PageParams: params})
+}
+
+// All returns a Pager over every User for App, starting at the page params.
+func (u *UserService) All(params PageParams) *Pager[User] {
+	return NewPager(params, func(params PageParams) ([]User, PageParams, error) {
+		params, err := params.limit(MaxPerPage)
+		if err != nil {
+			return nil, PageParams{}, err
+		}
+		userList, err := u.Repository.List(UserListParams{PageParams: params})
+		return userList.Users, userList.Pages, err
+	})
 }
 
 // List all Users for App via Scroll API
 func (u *UserService) Scroll(scrollParam string) (UserList, error) {
-       return u.Repository.scroll(scrollParam)
+       return u.Repository.Scroll(scrollParam)
 }
 
 // List Users by Segment.
 func (u *UserService) ListBySegment(segmentID string, params PageParams) (UserList, error) {
-	return u.Repository.list(userListParams{PageParams: params, SegmentID: segmentID})
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return UserList{}, err
+	}
+	return u.Repository.List(UserListParams{PageParams: params, SegmentID: segmentID})
 }
 
 // List Users By Tag.
 func (u *UserService) ListByTag(tagID string, params PageParams) (UserList, error) {
-	return u.Repository.list(userListParams{PageParams: params, TagID: tagID})
+	params, err := params.limit(MaxPerPage)
+	if err != nil {
+		return UserList{}, err
+	}
+	return u.Repository.List(UserListParams{PageParams: params, TagID: tagID})
 }
 
 // Save a User, creating or updating them.
 func (u *UserService) Save(user *User) (User, error) {
-	return u.Repository.save(user)
+	return u.Repository.Save(user)
 }
 
 func (u *UserService) Delete(id string) (User, error) {
-	return u.Repository.delete(id)
+	if id == "" {
+		return User{}, missing("User ID")
+	}
+	return u.Repository.Delete(id)
 }
 
 // Get the address for an User in order to message them
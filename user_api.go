@@ -1,20 +1,26 @@
 package intercom
 
 import (
-	"encoding/json"
-	"errors"
 	"fmt"
 
 	"gopkg.in/intercom/intercom-go.v2/interfaces"
 )
 
 // UserRepository defines the interface for working with Users through the API.
+// It can be implemented outside this package, such as to cache lookups, and set on the UserService;
+// intercomtest.RunUserRepositoryTests checks an implementation behaves as UserAPI does.
 type UserRepository interface {
-	find(UserIdentifiers) (User, error)
-	list(userListParams) (UserList, error)
-	scroll(scrollParam string) (UserList, error)
-	save(*User) (User, error)
-	delete(id string) (User, error)
+	// Find returns the User with the identifiers' ID, or else their UserID or Email, or an error matching ErrNotFound.
+	Find(UserIdentifiers) (User, error)
+	// List returns a page of Users, in the segment or with the tag if the params have one.
+	List(UserListParams) (UserList, error)
+	// Scroll returns the next batch of Users after the scroll param, or the first if it's empty,
+	// with the scroll param for the batch after.
+	Scroll(scrollParam string) (UserList, error)
+	// Save creates or updates the User, by their ID, UserID or Email, and returns them as saved.
+	Save(*User) (User, error)
+	// Delete deletes the User with the ID, returning them as they were, or an empty User if the API returns nothing.
+	Delete(id string) (User, error)
 }
 
 // UserAPI implements UserRepository
@@ -43,31 +49,35 @@ type requestUser struct {
 	LastSeenUserAgent      string                 `json:"last_seen_user_agent,omitempty"`
 }
 
-func (api UserAPI) find(params UserIdentifiers) (User, error) {
-	return unmarshalToUser(api.getClientForFind(params))
+func (api UserAPI) Find(params UserIdentifiers) (User, error) {
+	uri, data, err := api.getClientForFind(params)
+	return unmarshalToUser(uri, data, err)
 }
 
-func (api UserAPI) getClientForFind(params UserIdentifiers) ([]byte, error) {
+func (api UserAPI) getClientForFind(params UserIdentifiers) (string, []byte, error) {
 	switch {
 	case params.ID != "":
-		return api.httpClient.Get(fmt.Sprintf("/users/%s", params.ID), nil)
+		uri := fmt.Sprintf("/users/%s", params.ID)
+		data, err := api.httpClient.Get(uri, nil)
+		return uri, data, err
 	case params.UserID != "", params.Email != "":
-		return api.httpClient.Get("/users", params)
+		data, err := api.httpClient.Get("/users", params)
+		return "/users", data, err
 	}
-	return nil, errors.New("Missing User Identifier")
+	return "", nil, missing("User Identifier")
 }
 
-func (api UserAPI) list(params userListParams) (UserList, error) {
+func (api UserAPI) List(params UserListParams) (UserList, error) {
 	userList := UserList{}
 	data, err := api.httpClient.Get("/users", params)
 	if err != nil {
 		return userList, err
 	}
-	err = json.Unmarshal(data, &userList)
+	err = unmarshal("/users", data, &userList)
 	return userList, err
 }
 
-func (api UserAPI) scroll(scrollParam string) (UserList, error) {
+func (api UserAPI) Scroll(scrollParam string) (UserList, error) {
        userList := UserList{}
 
        url := "/users/scroll"
@@ -77,29 +87,31 @@ func (api UserAPI) scroll(scrollParam string) (UserList, error) {
        if err != nil {
                return userList, err
        }
-       err = json.Unmarshal(data, &userList)
+       err = unmarshal(url, data, &userList)
        return userList, err
 }
 
-func (api UserAPI) save(user *User) (User, error) {
-	return unmarshalToUser(api.httpClient.Post("/users", RequestUserMapper{}.ConvertUser(user)))
+func (api UserAPI) Save(user *User) (User, error) {
+	data, err := api.httpClient.Post("/users", RequestUserMapper{}.ConvertUser(user))
+	return unmarshalToUser("/users", data, err)
 }
 
-func unmarshalToUser(data []byte, err error) (User, error) {
+func unmarshalToUser(uri string, data []byte, err error) (User, error) {
 	savedUser := User{}
 	if err != nil {
 		return savedUser, err
 	}
-	err = json.Unmarshal(data, &savedUser)
+	err = unmarshal(uri, data, &savedUser)
 	return savedUser, err
 }
 
-func (api UserAPI) delete(id string) (User, error) {
+func (api UserAPI) Delete(id string) (User, error) {
 	user := User{}
-	data, err := api.httpClient.Delete(fmt.Sprintf("/users/%s", id), nil)
+	uri := fmt.Sprintf("/users/%s", id)
+	data, err := api.httpClient.Delete(uri, nil)
 	if err != nil {
 		return user, err
 	}
-	err = json.Unmarshal(data, &user)
+	err = unmarshalAccepted(uri, data, &user)
 	return user, err
 }
@@ -6,9 +6,9 @@ import (
 )
 
 func TestUserAPIFind(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/user.json", expectedURI: "/users/54c42e7ea7a765fa7", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/user.json", expectedURI: "/users/54c42e7ea7a765fa7", t: t}
 	api := UserAPI{httpClient: &http}
-	user, err := api.find(UserIdentifiers{ID: "54c42e7ea7a765fa7"})
+	user, err := api.Find(UserIdentifiers{ID: "54c42e7ea7a765fa7"})
 	if err != nil {
 		t.Errorf("Error parsing fixture %s", err)
 	}
@@ -30,18 +30,18 @@ func TestUserAPIFind(t *testing.T) {
 }
 
 func TestUserAPIFindByEmail(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/user.json", expectedURI: "/users", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/user.json", expectedURI: "/users", t: t}
 	api := UserAPI{httpClient: &http}
-	user, _ := api.find(UserIdentifiers{Email: "myuser@example.io"})
+	user, _ := api.Find(UserIdentifiers{Email: "myuser@example.io"})
 	if user.Email != "myuser@example.io" {
 		t.Errorf("Email was %s, expected myuser@example.io", user.Email)
 	}
 }
 
 func TestUserAPIListDefault(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/users.json", expectedURI: "/users", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/users.json", expectedURI: "/users", t: t}
 	api := UserAPI{httpClient: &http}
-	userList, _ := api.list(userListParams{})
+	userList, _ := api.List(UserListParams{})
 	users := userList.Users
 	if users[0].ID != "54c42e7ea7a765fa7" {
 		t.Errorf("ID was %s, expected 54c42e7ea7a765fa7", users[0].ID)
@@ -53,9 +53,9 @@ func TestUserAPIListDefault(t *testing.T) {
 }
 
 func TestUserAPIListWithPageNumber(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/users_page_2.json", expectedURI: "/users", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/users_page_2.json", expectedURI: "/users", t: t}
 	api := UserAPI{httpClient: &http}
-	userList, _ := api.list(userListParams{PageParams: PageParams{Page: 2}})
+	userList, _ := api.List(UserListParams{PageParams: PageParams{Page: 2}})
 	pages := userList.Pages
 	if pages.Page != 2 {
 		t.Errorf("Page was %d, expected 2", pages.Page)
@@ -63,19 +63,19 @@ func TestUserAPIListWithPageNumber(t *testing.T) {
 }
 
 func TestUserAPIListWithSegment(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/users.json", expectedURI: "/users", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/users.json", expectedURI: "/users", t: t}
 	api := UserAPI{httpClient: &http}
-	api.list(userListParams{SegmentID: "abc123"})
-	if ulParams, ok := http.lastQueryParams.(userListParams); !ok || ulParams.SegmentID != "abc123" {
+	api.List(UserListParams{SegmentID: "abc123"})
+	if ulParams, ok := http.lastQueryParams.(UserListParams); !ok || ulParams.SegmentID != "abc123" {
 		t.Errorf("SegmentID expected to be abc123, but was %s", ulParams.SegmentID)
 	}
 }
 
 func TestUserAPIListWithTag(t *testing.T) {
-	http := TestUserHTTPClient{fixtureFilename: "fixtures/users.json", expectedURI: "/users", t: t}
+	http := TestUserHTTPClient{fixtureFilename: "intercomtest/fixtures/users.json", expectedURI: "/users", t: t}
 	api := UserAPI{httpClient: &http}
-	api.list(userListParams{TagID: "123"})
-	if ulParams, ok := http.lastQueryParams.(userListParams); !ok || ulParams.TagID != "123" {
+	api.List(UserListParams{TagID: "123"})
+	if ulParams, ok := http.lastQueryParams.(UserListParams); !ok || ulParams.TagID != "123" {
 		t.Errorf("SegmentID expected to be 123, but was %s", ulParams.TagID)
 	}
 }
@@ -89,13 +89,13 @@ func TestUserAPISave(t *testing.T) {
 		},
 	}
 	user := User{UserID: "27", Companies: &companyList}
-	api.save(&user)
+	api.Save(&user)
 }
 
 func TestUserAPIDelete(t *testing.T) {
 	http := TestUserHTTPClient{t: t, expectedURI: "/users/1234"}
 	api := UserAPI{httpClient: &http}
-	api.delete("1234")
+	api.Delete("1234")
 }
 
 type TestUserHTTPClient struct {
@@ -64,19 +64,19 @@ type TestUserAPI struct {
 	t *testing.T
 }
 
-func (t TestUserAPI) find(params UserIdentifiers) (User, error) {
+func (t TestUserAPI) Find(params UserIdentifiers) (User, error) {
 	return User{ID: params.ID, Email: params.Email, UserID: params.UserID}, nil
 }
 
-func (t TestUserAPI) list(params userListParams) (UserList, error) {
+func (t TestUserAPI) List(params UserListParams) (UserList, error) {
 	return UserList{Users: []User{User{ID: "46adad3f09126dca", Email: "jamie@example.io", UserID: "aa123"}}}, nil
 }
 
-func (t TestUserAPI) scroll(scrollParam string) (UserList, error) {
+func (t TestUserAPI) Scroll(scrollParam string) (UserList, error) {
 	return UserList{Users: []User{User{ID: "46adad3f09126dca", Email: "jamie@example.io", UserID: "aa123"}}}, nil
 }
 
-func (t TestUserAPI) save(user *User) (User, error) {
+func (t TestUserAPI) Save(user *User) (User, error) {
 	if user.ID != "46adad3f09126dca" {
 		t.t.Errorf("User ID was %s, expected 46adad3f09126dca", user.ID)
 	}
@@ -87,7 +87,7 @@ func (t TestUserAPI) save(user *User) (User, error) {
 	return User{}, nil
 }
 
-func (t TestUserAPI) delete(id string) (User, error) {
+func (t TestUserAPI) Delete(id string) (User, error) {
 	if id != "46adad3f09126dca" {
 		t.t.Errorf("id was %s, expected 46adad3f09126dca", id)
 	}
@@ -0,0 +1,47 @@
+package intercom
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"strings"
+)
+
+var (
+	// ErrMalformedSignature is returned when a webhook signature header cannot be parsed.
+	ErrMalformedSignature = errors.New("Malformed Webhook Signature")
+	// ErrSignatureMismatch is returned when a webhook signature does not match its body.
+	ErrSignatureMismatch = errors.New("Webhook Signature Mismatch")
+)
+
+// VerifyWebhookSignature checks a webhook body against its X-Hub-Signature header,
+// which is of the form "sha1=<hex digest>" (or "sha256=<hex digest>"), signed with
+// the Subscription's HubSecret.
+func VerifyWebhookSignature(secret []byte, body []byte, signatureHeader string) error {
+	parts := strings.SplitN(signatureHeader, "=", 2)
+	if len(parts) != 2 {
+		return ErrMalformedSignature
+	}
+	var newHash func() hash.Hash
+	switch parts[0] {
+	case "sha1":
+		newHash = sha1.New
+	case "sha256":
+		newHash = sha256.New
+	default:
+		return ErrMalformedSignature
+	}
+	signature, err := hex.DecodeString(parts[1])
+	if err != nil || len(signature) != newHash().Size() {
+		return ErrMalformedSignature
+	}
+	mac := hmac.New(newHash, secret)
+	mac.Write(body)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return ErrSignatureMismatch
+	}
+	return nil
+}
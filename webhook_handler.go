@@ -0,0 +1,80 @@
+package intercom
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+)
+
+// maxWebhookBodySize limits how much of a webhook request body is read.
+const maxWebhookBodySize = 5 << 20
+
+// WebhookStatusError can be returned from a webhook handler to choose the
+// status code sent back to Intercom. Other errors are answered with 500.
+type WebhookStatusError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e WebhookStatusError) Error() string {
+	return e.Err.Error()
+}
+
+// WebhookHandler returns a http.Handler which receives Intercom notifications.
+// Each request is checked against secret (skipped if secret is empty) and parsed
+// before being passed to handler. Intercom's HEAD validation requests and "ping"
+// notifications are always answered with 200, so Subscriptions can be set up
+// before handler knows about them.
+//
+// Notifications whose item can't be decoded are still passed to handler, as NewNotification
+// parses them: with the field for their Topic left nil, and the item available from Item.
+// They're acknowledged unless handler returns an error, so Intercom doesn't keep retrying them.
+func WebhookHandler(secret []byte, handler func(context.Context, *Notification) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodHead:
+			w.WriteHeader(http.StatusOK)
+			return
+		case http.MethodPost:
+		default:
+			w.Header().Set("Allow", "HEAD, POST")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+		if err != nil {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		if len(secret) > 0 {
+			if err := VerifyWebhookSignature(secret, body, r.Header.Get("X-Hub-Signature")); err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+		notification, err := NewNotification(bytes.NewReader(body))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = handler(r.Context(), notification)
+		if notification.Topic == TopicPing {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if err != nil {
+			var statusErr WebhookStatusError
+			if errors.As(err, &statusErr) {
+				w.WriteHeader(statusErr.StatusCode)
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,99 @@
+package intercom
+
+import (
+	"context"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	secret := []byte("s3cr3t")
+	body := `{"topic": "user.created", "data": {"item": {"type": "user", "id": "abc"}}}`
+	var received *Notification
+	handler := WebhookHandler(secret, func(ctx context.Context, n *Notification) error {
+		received = n
+		return nil
+	})
+
+	status := serveWebhook(handler, "POST", body, "sha1="+signWebhook(sha1.New, secret, []byte(body)))
+	if status != http.StatusOK {
+		t.Errorf("Status was %d, expected 200", status)
+	}
+	if received == nil || received.User == nil || received.User.ID != "abc" {
+		t.Errorf("Handler did not receive the notification, got %v", received)
+	}
+}
+
+func TestWebhookHandlerStatuses(t *testing.T) {
+	secret := []byte("s3cr3t")
+	handlerErr := errors.New("Failed")
+	handler := WebhookHandler(secret, func(ctx context.Context, n *Notification) error {
+		switch n.Topic {
+		case "user.deleted":
+			return handlerErr
+		case "user.unsubscribed":
+			return WebhookStatusError{StatusCode: http.StatusUnprocessableEntity, Err: handlerErr}
+		case "user.email.updated":
+			return fmt.Errorf("handling: %w", WebhookStatusError{StatusCode: http.StatusConflict, Err: handlerErr})
+		}
+		return handlerErr
+	})
+	sign := func(body string) string { return "sha1=" + signWebhook(sha1.New, secret, []byte(body)) }
+	cases := []struct {
+		method, body, signature string
+		expected                int
+	}{
+		{"HEAD", "", "", http.StatusOK},
+		{"GET", "", "", http.StatusMethodNotAllowed},
+		{"POST", `{"topic": "ping"}`, sign(`{"topic": "ping"}`), http.StatusOK},
+		{"POST", `{"topic": "ping"}`, sign(`{}`), http.StatusUnauthorized},
+		{"POST", `{"topic": "ping"}`, "", http.StatusUnauthorized},
+		{"POST", `not json`, sign(`not json`), http.StatusBadRequest},
+		{"POST", `{"topic": "user.deleted"}`, sign(`{"topic": "user.deleted"}`), http.StatusInternalServerError},
+		{"POST", `{"topic": "user.unsubscribed"}`, sign(`{"topic": "user.unsubscribed"}`), http.StatusUnprocessableEntity},
+		{"POST", `{"topic": "user.email.updated"}`, sign(`{"topic": "user.email.updated"}`), http.StatusConflict},
+	}
+	for _, c := range cases {
+		if status := serveWebhook(handler, c.method, c.body, c.signature); status != c.expected {
+			t.Errorf("%s %s gave %d, expected %d", c.method, c.body, status, c.expected)
+		}
+	}
+}
+
+func TestWebhookHandlerUndecodableItem(t *testing.T) {
+	body := `{"topic": "user.created", "data": {"item": "not a user"}}`
+	var received *Notification
+	handler := WebhookHandler(nil, func(ctx context.Context, n *Notification) error {
+		received = n
+		return nil
+	})
+	if status := serveWebhook(handler, "POST", body, ""); status != http.StatusOK {
+		t.Errorf("Status was %d, expected the notification to be acknowledged with 200", status)
+	}
+	if received == nil || received.User != nil || string(received.Item()) != `"not a user"` {
+		t.Errorf("Expected the handler to receive the notification with its raw item, got %v", received)
+	}
+}
+
+func TestWebhookHandlerBodyLimit(t *testing.T) {
+	handler := WebhookHandler(nil, func(ctx context.Context, n *Notification) error { return nil })
+	body := `{"topic": "ping", "padding": "` + strings.Repeat("a", maxWebhookBodySize) + `"}`
+	if status := serveWebhook(handler, "POST", body, ""); status != http.StatusRequestEntityTooLarge {
+		t.Errorf("Status was %d, expected 413", status)
+	}
+}
+
+func serveWebhook(handler http.Handler, method, body, signature string) int {
+	req := httptest.NewRequest(method, "/webhooks", strings.NewReader(body))
+	if signature != "" {
+		req.Header.Set("X-Hub-Signature", signature)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Code
+}
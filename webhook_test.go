@@ -0,0 +1,35 @@
+package intercom
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	secret := []byte("s3cr3t")
+	body := []byte(`{"topic":"ping"}`)
+	cases := map[string]error{
+		"sha1=" + signWebhook(sha1.New, secret, body):          nil,
+		"sha256=" + signWebhook(sha256.New, secret, body):      nil,
+		"sha1=" + signWebhook(sha1.New, []byte("wrong"), body): ErrSignatureMismatch,
+		"sha256=" + signWebhook(sha1.New, secret, body):        ErrMalformedSignature,
+		"sha1=nothex": ErrMalformedSignature,
+		"md5=" + signWebhook(sha1.New, secret, body): ErrMalformedSignature,
+		signWebhook(sha1.New, secret, body):          ErrMalformedSignature,
+	}
+	for header, expected := range cases {
+		if err := VerifyWebhookSignature(secret, body, header); err != expected {
+			t.Errorf("Header %q gave %v, expected %v", header, err, expected)
+		}
+	}
+}
+
+func signWebhook(newHash func() hash.Hash, secret, body []byte) string {
+	mac := hmac.New(newHash, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}